@@ -0,0 +1,246 @@
+package goSmartSheet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AttachmentType represents the source of an Attachment
+type AttachmentType string
+
+const (
+	AttachmentTypeFile        AttachmentType = "FILE"
+	AttachmentTypeLink        AttachmentType = "LINK"
+	AttachmentTypeBox         AttachmentType = "BOX_COM"
+	AttachmentTypeDropbox     AttachmentType = "DROPBOX"
+	AttachmentTypeEgnyte      AttachmentType = "EGNYTE"
+	AttachmentTypeEvernote    AttachmentType = "EVERNOTE"
+	AttachmentTypeGoogleDrive AttachmentType = "GOOGLE_DRIVE"
+	AttachmentTypeOneDrive    AttachmentType = "ONEDRIVE"
+)
+
+// MiniUser is the reduced user object used to show who created an object
+type MiniUser struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// Attachment is a file or link attached to a sheet, row or comment
+// https://smartsheet-platform.github.io/api-docs/#attachment-object
+type Attachment struct {
+	ID                 int64          `json:"id,omitempty"`
+	ParentID           int64          `json:"parentId,omitempty"`
+	ParentType         string         `json:"parentType,omitempty"`
+	AttachmentType     AttachmentType `json:"attachmentType,omitempty"`
+	AttachmentSubType  string         `json:"attachmentSubType,omitempty"`
+	MimeType           string         `json:"mimeType,omitempty"`
+	Name               string         `json:"name,omitempty"`
+	Description        string         `json:"description,omitempty"`
+	SizeInKb           int64          `json:"sizeInKb,omitempty"`
+	URL                string         `json:"url,omitempty"`
+	URLExpiresInMillis int64          `json:"urlExpiresInMillis,omitempty"`
+	CreatedAt          *time.Time     `json:"createdAt,omitempty"`
+	CreatedBy          *MiniUser      `json:"createdBy,omitempty"`
+}
+
+// ListSheetAttachments returns every attachment on the sheet including those on rows and comments
+func (c *Client) ListSheetAttachments(sheetID string) ([]Attachment, error) {
+	return getAllPages[Attachment](c, fmt.Sprintf("sheets/%v/attachments", sheetID))
+}
+
+// ListRowAttachments returns the attachments on the row including those on its comments
+func (c *Client) ListRowAttachments(sheetID string, rowID int64) ([]Attachment, error) {
+	return getAllPages[Attachment](c, fmt.Sprintf("sheets/%v/rows/%v/attachments", sheetID, rowID))
+}
+
+// ListDiscussionAttachments returns the attachments on the comments within the discussion
+func (c *Client) ListDiscussionAttachments(sheetID string, discussionID int64) ([]Attachment, error) {
+	return getAllPages[Attachment](c, fmt.Sprintf("sheets/%v/discussions/%v/attachments", sheetID, discussionID))
+}
+
+// GetAttachment returns the attachment including a short lived URL to download its content
+func (c *Client) GetAttachment(sheetID string, attachmentID int64) (*Attachment, error) {
+	return c.getAttachment(context.Background(), sheetID, attachmentID)
+}
+
+func (c *Client) getAttachment(ctx context.Context, sheetID string, attachmentID int64) (*Attachment, error) {
+	a := &Attachment{}
+	if err := c.getObjectContext(ctx, fmt.Sprintf("sheets/%v/attachments/%v", sheetID, attachmentID), a); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get attachment (ID: %v)", attachmentID)
+	}
+
+	return a, nil
+}
+
+// DeleteAttachment removes the attachment and all of its versions
+func (c *Client) DeleteAttachment(sheetID string, attachmentID int64) error {
+	return c.deleteObject(fmt.Sprintf("sheets/%v/attachments/%v", sheetID, attachmentID))
+}
+
+// AttachFileToSheet streams the content of r to a new file attachment on the sheet.  size must be the exact length of r.
+func (c *Client) AttachFileToSheet(sheetID, name, contentType string, r io.Reader, size int64) (*Attachment, error) {
+	return c.uploadAttachment(fmt.Sprintf("sheets/%v/attachments", sheetID), name, contentType, r, size)
+}
+
+// AttachFileToRow streams the content of r to a new file attachment on the row.  size must be the exact length of r.
+func (c *Client) AttachFileToRow(sheetID string, rowID int64, name, contentType string, r io.Reader, size int64) (*Attachment, error) {
+	return c.uploadAttachment(fmt.Sprintf("sheets/%v/rows/%v/attachments", sheetID, rowID), name, contentType, r, size)
+}
+
+// AttachFileToComment streams the content of r to a new file attachment on the comment as a multipart upload.
+// size must be the exact length of r.
+func (c *Client) AttachFileToComment(sheetID string, commentID int64, name, contentType string, r io.Reader, size int64) (*Attachment, error) {
+	path := fmt.Sprintf("sheets/%v/comments/%v/attachments", sheetID, commentID)
+
+	//the part header and closing boundary are written up front so the length of the body is known
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": "file", "filename": name}))
+	h.Set("Content-Type", contentType)
+	if _, err := mw.CreatePart(h); err != nil {
+		return nil, errors.Wrap(err, "Failed to create multipart upload")
+	}
+	headLen := buf.Len()
+	if err := mw.Close(); err != nil {
+		return nil, errors.Wrap(err, "Failed to create multipart upload")
+	}
+	head, tail := buf.Bytes()[:headLen], buf.Bytes()[headLen:]
+
+	hdrs := map[string]string{
+		"Content-Type":   mw.FormDataContentType(),
+		"Content-Length": strconv.FormatInt(int64(len(head))+size+int64(len(tail)), 10),
+	}
+
+	body := io.MultiReader(bytes.NewReader(head), r, bytes.NewReader(tail))
+	resp, _, err := c.doInto(context.Background(), &Attachment{}, "POST", path, body, hdrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeAttachment(resp)
+}
+
+// AttachURLToSheet adds a link attachment to the sheet.  Name, URL and AttachmentType must be populated.
+func (c *Client) AttachURLToSheet(sheetID string, a Attachment) (*Attachment, error) {
+	return c.attachURL(fmt.Sprintf("sheets/%v/attachments", sheetID), a)
+}
+
+// AttachURLToRow adds a link attachment to the row.  Name, URL and AttachmentType must be populated.
+func (c *Client) AttachURLToRow(sheetID string, rowID int64, a Attachment) (*Attachment, error) {
+	return c.attachURL(fmt.Sprintf("sheets/%v/rows/%v/attachments", sheetID, rowID), a)
+}
+
+// AttachURLToComment adds a link attachment to the comment.  Name, URL and AttachmentType must be populated.
+func (c *Client) AttachURLToComment(sheetID string, commentID int64, a Attachment) (*Attachment, error) {
+	return c.attachURL(fmt.Sprintf("sheets/%v/comments/%v/attachments", sheetID, commentID), a)
+}
+
+// ListAttachmentVersions returns every version of the attachment
+func (c *Client) ListAttachmentVersions(sheetID string, attachmentID int64) ([]Attachment, error) {
+	return getAllPages[Attachment](c, fmt.Sprintf("sheets/%v/attachments/%v/versions", sheetID, attachmentID))
+}
+
+// AttachNewVersion streams the content of r as a new version of the attachment.  size must be the exact length of r.
+func (c *Client) AttachNewVersion(sheetID string, attachmentID int64, name, contentType string, r io.Reader, size int64) (*Attachment, error) {
+	return c.uploadAttachment(fmt.Sprintf("sheets/%v/attachments/%v/versions", sheetID, attachmentID), name, contentType, r, size)
+}
+
+// DeleteAttachmentVersions removes every version of the attachment other than the attachment itself
+func (c *Client) DeleteAttachmentVersions(sheetID string, attachmentID int64) error {
+	return c.deleteObject(fmt.Sprintf("sheets/%v/attachments/%v/versions", sheetID, attachmentID))
+}
+
+// DownloadAttachment returns a stream of the content of the attachment.  The content is read directly from the
+// pre-signed URL and is never buffered, the caller must close the returned ReadCloser.
+func (c *Client) DownloadAttachment(sheetID string, attachmentID int64) (io.ReadCloser, *Attachment, error) {
+	return c.DownloadAttachmentWithContext(context.Background(), sheetID, attachmentID)
+}
+
+// DownloadAttachmentWithContext is the same as DownloadAttachment but makes the requests with the context,
+// cancelling the context also stops the stream
+func (c *Client) DownloadAttachmentWithContext(ctx context.Context, sheetID string, attachmentID int64) (io.ReadCloser, *Attachment, error) {
+	a, err := c.getAttachment(ctx, sheetID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if a.URL == "" {
+		return nil, a, errors.Errorf("Attachment %v of type %v cannot be downloaded", a.ID, a.AttachmentType)
+	}
+
+	//the URL is pre-signed so the Authorization header must not be sent
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.URL, nil)
+	if err != nil {
+		return nil, a, errors.Wrapf(err, "Failed to create download request for attachment (ID: %v)", a.ID)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, a, errors.Wrapf(err, "Failed to download attachment (ID: %v)", a.ID)
+	}
+
+	body, err := checkResponse(resp.StatusCode, resp.Body)
+	if err != nil {
+		return nil, a, errors.Wrapf(err, "Failed to download attachment (ID: %v)", a.ID)
+	}
+
+	return body, a, nil
+}
+
+func (c *Client) uploadAttachment(path, name, contentType string, r io.Reader, size int64) (*Attachment, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	h := map[string]string{
+		"Content-Type":        contentType,
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": name}),
+		"Content-Length":      strconv.FormatInt(size, 10),
+	}
+
+	resp, _, err := c.doInto(context.Background(), &Attachment{}, "POST", path, r, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeAttachment(resp)
+}
+
+func (c *Client) attachURL(path string, a Attachment) (*Attachment, error) {
+	if a.AttachmentType == "" {
+		a.AttachmentType = AttachmentTypeLink
+	}
+
+	added := &Attachment{}
+	body, err := c.postObjectInto(added, path, a)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, added); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+func decodeAttachment(body io.ReadCloser) (*Attachment, error) {
+	a := &Attachment{}
+	if err := decodeAsResultResponseInto(body, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
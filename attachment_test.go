@@ -0,0 +1,94 @@
+package goSmartSheet
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Attachments(t *testing.T) {
+	assert := assert.New(t)
+
+	var srv *httptest.Server
+	var uploaded, disposition, multipartName, multipartBody string
+	var contentLength, multipartLength, multipartRead int64
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/file":
+			assert.Empty(r.Header.Get("Authorization"))
+			io.WriteString(w, "file content")
+		case r.Method == "GET" && r.URL.Path == "/2.0/sheets/1/attachments":
+			if r.URL.Query().Get("page") == "1" {
+				io.WriteString(w, `{"pageNumber":1,"totalPages":2,"data":[{"id":1,"name":"a.txt"}]}`)
+			} else {
+				io.WriteString(w, `{"pageNumber":2,"totalPages":2,"data":[{"id":2,"name":"b.txt"}]}`)
+			}
+		case r.Method == "GET" && r.URL.Path == "/2.0/sheets/1/attachments/5":
+			io.WriteString(w, `{"id":5,"name":"a.txt","attachmentType":"FILE","url":"`+srv.URL+`/file"}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/sheets/1/rows/2/attachments":
+			b, _ := io.ReadAll(r.Body)
+			uploaded = string(b)
+			contentLength = r.ContentLength
+			disposition = r.Header.Get("Content-Disposition")
+			io.WriteString(w, `{"resultCode":0,"result":{"id":6,"name":"up.txt"}}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/sheets/1/comments/3/attachments":
+			b, _ := io.ReadAll(r.Body)
+			multipartLength = r.ContentLength
+			multipartRead = int64(len(b))
+			r.Body = io.NopCloser(bytes.NewReader(b))
+
+			f, h, err := r.FormFile("file")
+			if assert.NoError(err) {
+				b, _ := io.ReadAll(f)
+				multipartBody = string(b)
+				multipartName = h.Filename
+			}
+			io.WriteString(w, `{"resultCode":0,"result":{"id":7,"name":"c.txt"}}`)
+		case r.Method == "DELETE":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	list, err := c.ListSheetAttachments("1")
+	assert.NoError(err)
+	assert.Len(list, 2)
+
+	a, err := c.AttachFileToRow("1", 2, "up.txt", "text/plain", strings.NewReader("hello"), 5)
+	assert.NoError(err)
+	assert.Equal(int64(6), a.ID)
+	assert.Equal("hello", uploaded)
+	assert.Equal(int64(5), contentLength)
+	assert.Equal(`attachment; filename=up.txt`, disposition)
+
+	a, err = c.AttachFileToComment("1", 3, "c.txt", "text/plain", strings.NewReader("comment file"), 12)
+	assert.NoError(err)
+	assert.Equal(int64(7), a.ID)
+	assert.Equal(multipartRead, multipartLength)
+	assert.True(multipartLength > 12)
+	assert.Equal("c.txt", multipartName)
+	assert.Equal("comment file", multipartBody)
+
+	rc, a, err := c.DownloadAttachment("1", 5)
+	assert.NoError(err)
+	assert.Equal("a.txt", a.Name)
+	b, _ := io.ReadAll(rc)
+	rc.Close()
+	assert.Equal("file content", string(b))
+
+	assert.NoError(c.DeleteAttachment("1", 5))
+
+	_, err = c.GetAttachment("1", 404)
+	assert.Error(err)
+}
@@ -10,6 +10,9 @@ type Cell struct {
 	ColumnID     int64      `json:"columnId"`
 	Value        *CellValue `json:"value,omitempty"` //TODO: should this be a pointer?
 	DisplayValue string     `json:"displayValue,omitempty"`
+
+	//only populated on report cells, the virtualId of the report column
+	VirtualColumnID int64 `json:"virtualColumnId,omitempty"`
 }
 
 //CellValue represents the possible strongly typed values that could exist in a SS cell
@@ -149,3 +152,23 @@ func (c *CellValue) UnmarshalJSON(b []byte) (err error) {
 	c.Value = json.RawMessage(b) //default to raw message
 	return
 }
+
+//Equal reports whether both values represent the same cell content regardless of the underlying type.
+//A nil value is considered equal to a blank value.
+func (c *CellValue) Equal(o *CellValue) bool {
+	return c.normalized() == o.normalized()
+}
+
+//normalized returns the comparable string form of the value treating nil and null as blank
+func (c *CellValue) normalized() string {
+	if c == nil {
+		return ""
+	}
+
+	s := c.String()
+	if s == "null" {
+		return ""
+	}
+
+	return s
+}
@@ -221,3 +221,22 @@ func BenchmarkUnmarshallFloat(b *testing.B) {
 		cv.UnmarshalJSON(d)
 	}
 }
+
+func TestCellValue_Equal(t *testing.T) {
+	assert := assert.New(t)
+	var i, f, s, blank CellValue
+
+	i.SetInt(5)
+	f.SetFloat(5.0)
+	s.SetString("5")
+	blank.SetString("")
+
+	assert.True(i.Equal(&f))
+	assert.True(f.Equal(&s))
+	assert.True(blank.Equal(nil))
+	assert.True((*CellValue)(nil).Equal(nil))
+	assert.False(i.Equal(nil))
+
+	f.SetFloat(5.5)
+	assert.False(i.Equal(&f))
+}
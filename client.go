@@ -2,12 +2,13 @@ package goSmartSheet
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -16,11 +17,23 @@ import (
 
 // Client is used to interact with the SamartSheet API
 type Client struct {
-	url    string
-	apiKey string
-	client *http.Client
+	url string
+	//credentials supplies the bearer token of each request
+	credentials CredentialProvider
+	client      *http.Client
+	//indexes created through IndexSheet, these are invalidated when a sheet is changed
+	indexes *indexRegistry
+	//options applied to every request made by the client, such as Assume-User
+	options []RequestOption
 	//VerboseMode set to true will log extra debug when the client is commmunicating with the server
+	//through the log package, Logger takes precedence when set
 	VerboseMode bool
+	//Logger receives structured logs of every request, such as a *slog.Logger
+	Logger Logger
+	//LogOptions controls body logging and redaction
+	LogOptions LogOptions
+	//middleware added through Use, the first is the outermost
+	middleware []Middleware
 }
 
 // GetClient will return back a SmartSheet client based on the specified apiKey
@@ -31,9 +44,20 @@ func GetClient(apiKey string, u string) (api *Client, err error) {
 		return
 	}
 
+	api, err = newClient(u)
+	if err != nil {
+		return
+	}
+
+	api.credentials = StaticCredentials(apiKey)
+	return
+}
+
+// newClient returns a client for the URL without any credentials
+func newClient(u string) (api *Client, err error) {
 	//default to prod API
 	if u == "" {
-		u = "https://api.smartsheet.com/2.0"
+		u = RegionUS.BaseURL()
 	}
 
 	//validate url
@@ -42,7 +66,7 @@ func GetClient(apiKey string, u string) (api *Client, err error) {
 		return
 	}
 
-	api = &Client{url: u, apiKey: apiKey}
+	api = &Client{url: u, indexes: &indexRegistry{}}
 	api.client = &http.Client{} //per docs clients should be made once, https://golang.org/pkg/net/http/
 	return
 }
@@ -80,28 +104,19 @@ func (c *Client) GetSheetFilterCols(id string, onlyTheseColumns []string) (*Shee
 
 // GetSheet returns a sheet with the specified Id
 func (c *Client) GetSheet(id, queryFilter string) (s *Sheet, err error) {
+	return c.GetSheetWithContext(context.Background(), id, queryFilter)
+}
+
+// GetSheetWithContext is the same as GetSheet but makes the request with the context
+func (c *Client) GetSheetWithContext(ctx context.Context, id, queryFilter string) (s *Sheet, err error) {
 	path := "sheets/" + id
 	if queryFilter != "" {
 		path += "?" + queryFilter
 	}
 
-	body, statusCode, err := c.Get(path)
-	if err != nil {
-		err = errors.Wrapf(err, "Failed to get sheet (ID: %v)", id)
-		return
-
-	}
-	defer body.Close()
-
-	dec := json.NewDecoder(body)
-
-	if statusCode == 200 {
-		s = &Sheet{}
-		if err = dec.Decode(s); err != nil {
-			err = errors.Wrap(err, "Failed to decode into Sheet")
-		}
-	} else {
-		err = ErrorItemDecode(statusCode, dec)
+	s = &Sheet{}
+	if err = c.getObjectContext(ctx, path, s); err != nil {
+		s, err = nil, errors.Wrapf(err, "Failed to get sheet (ID: %v)", id)
 	}
 
 	return
@@ -112,7 +127,7 @@ func (c *Client) GetSheet(id, queryFilter string) (s *Sheet, err error) {
 func (c *Client) CreateSheet(s *Sheet) (string, error) {
 	path := "sheets/"
 
-	body, err := c.PostObject(path, s)
+	body, err := c.postObjectInto(s, path, s)
 	if err != nil {
 		return "", err
 	}
@@ -130,12 +145,12 @@ func (c *Client) CreateSheet(s *Sheet) (string, error) {
 func (c *Client) CopySheet(id string, cd *ContainerDestination) (*Sheet, error) {
 	path := fmt.Sprintf("sheets/%v/copy", id)
 
-	body, err := c.PostObject(path, cd)
+	s := &Sheet{}
+	body, err := c.postObjectInto(s, path, cd)
 	if err != nil {
 		return nil, err
 	}
 
-	s := &Sheet{}
 	err = decodeAsResultResponseInto(body, s)
 
 	return s, err
@@ -144,47 +159,94 @@ func (c *Client) CopySheet(id string, cd *ContainerDestination) (*Sheet, error)
 // GetColumns will return back the columns for the specified Sheet
 func (c *Client) GetColumns(sheetID string) (cols []Column, err error) {
 	path := fmt.Sprintf("sheets/%v/columns", sheetID)
+	return getAllPages[Column](c, path)
+}
+
+// columnAdd is the body of a new column, which cannot contain an ID
+type columnAdd struct {
+	Index   int      `json:"index"`
+	Title   string   `json:"title"`
+	Type    string   `json:"type"`
+	Width   int      `json:"width,omitempty"`
+	Options []string `json:"options,omitempty"`
+	Formula string   `json:"formula,omitempty"`
+}
 
-	body, statusCode, err := c.Get(path)
+// AddColumn will insert the column into the sheet at the position specified by its Index
+func (c *Client) AddColumn(sheetID string, col Column) (*Column, error) {
+	add := columnAdd{Index: col.Index, Title: col.Title, Type: col.Type, Width: col.Width, Options: col.Options, Formula: col.Formula}
+	var added []Column
+	body, err := c.postObjectInto(&added, fmt.Sprintf("sheets/%v/columns", sheetID), []columnAdd{add})
 	if err != nil {
 		return nil, err
 	}
-	defer body.Close()
+	c.indexes.invalidate(sheetID)
 
-	var resp PaginatedResponse
-	//TODO: need generic handling and ability to read from pages to get all data... eventually
-	dec := json.NewDecoder(body)
-	if statusCode == 200 {
-		if err = dec.Decode(&resp); err != nil {
-			return nil, errors.Wrap(err, "Call seems successful, but failed to decode response")
-		}
+	if err = decodeAsResultResponseInto(body, &added); err != nil {
+		return nil, err
+	}
 
-		if err = json.Unmarshal(resp.Data, &cols); err != nil {
-			return nil, errors.Wrap(err, "Call seems successful, but failed to decode columns")
-		}
-	} else {
-		err = ErrorItemDecode(statusCode, dec)
+	if len(added) == 0 {
+		return nil, errors.New("Call seems successful, but no column was returned")
 	}
 
-	return
+	return &added[0], nil
 }
 
-func decodeAsResultResponseInto(body io.ReadCloser, v interface{}) error {
-	var err error
-	dec := json.NewDecoder(body)
-	defer body.Close()
+// UpdateColumnRequest contains the properties of a column to change, nil properties are left unchanged.
+// An empty Formula or Options removes the formula or options from the column.
+type UpdateColumnRequest struct {
+	Index   *int      `json:"index,omitempty"`
+	Title   *string   `json:"title,omitempty"`
+	Type    *string   `json:"type,omitempty"`
+	Width   *int      `json:"width,omitempty"`
+	Options *[]string `json:"options,omitempty"`
+	Formula *string   `json:"formula,omitempty"`
+}
+
+// UpdateColumn will change the properties of the column set within u, including moving it to Index
+func (c *Client) UpdateColumn(sheetID string, columnID int64, u UpdateColumnRequest) (*Column, error) {
+	if u.Options != nil && *u.Options == nil {
+		//an empty list is required to remove the options, null is ignored
+		u.Options = &[]string{}
+	}
+
+	updated := &Column{}
+	body, err := c.putObjectInto(updated, fmt.Sprintf("sheets/%v/columns/%v", sheetID, columnID), u)
+	if err != nil {
+		return nil, err
+	}
+	c.indexes.invalidate(sheetID)
+
+	if err = decodeAsResultResponseInto(body, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
 
+// DeleteColumn will remove the column and all of its data from the sheet
+func (c *Client) DeleteColumn(sheetID string, columnID int64) error {
+	if err := c.deleteObject(fmt.Sprintf("sheets/%v/columns/%v", sheetID, columnID)); err != nil {
+		return err
+	}
+
+	c.indexes.invalidate(sheetID)
+	return nil
+}
+
+func decodeAsResultResponseInto(body io.ReadCloser, v interface{}) error {
 	r := &ResultResponse{}
-	if err = dec.Decode(r); err != nil {
-		return errors.Wrap(err, "Failed to decode into Result")
+	if err := decodeInto(body, r); err != nil {
+		return err
 	}
 
-	if r.ResultCode != 0 {
-		return errors.Wrap(err, "Result Code returned non-success")
+	if err := r.check(); err != nil {
+		return err
 	}
 
 	//try to decode as specified object
-	if err = json.Unmarshal(r.Result, v); err != nil {
+	if err := json.Unmarshal(r.Result, v); err != nil {
 		return errors.Wrapf(err, "Failed to decode into object %T", v)
 	}
 
@@ -284,10 +346,11 @@ func (c *Client) AddRowsToSheet(sheetID string, rowOpt RowPostOptions, rows []Ro
 		}
 	}
 
-	body, err := c.PostObject(fmt.Sprintf("sheets/%v/rows", sheetID), rows)
+	body, err := c.postObjectInto(&[]Row{}, fmt.Sprintf("sheets/%v/rows", sheetID), rows)
 	if err != nil {
 		return nil, err
 	}
+	c.indexes.invalidate(sheetID)
 
 	return body, nil
 }
@@ -303,9 +366,14 @@ func (c *Client) DeleteRowsFromSheet(sheetID string, rows []Row) (io.ReadCloser,
 }
 
 // DeleteRowsIdsFromSheet will delete the specified rowIDs from the specified sheet
+// Unsuccessful responses are returned as an error, otherwise the caller must close the body
 func (c *Client) DeleteRowsIdsFromSheet(sheetID string, ids []string) (io.ReadCloser, int, error) {
 	path := fmt.Sprintf("sheets/%v/rows?ids=%v", sheetID, strings.Join(ids, ","))
-	return c.Delete(path)
+	body, status, err := c.Delete(path)
+	if err == nil {
+		c.indexes.invalidate(sheetID)
+	}
+	return body, status, err
 }
 
 //TODO: need to see success response as well... think it also looks like error item
@@ -314,7 +382,34 @@ func (c *Client) DeleteRowsIdsFromSheet(sheetID string, ids []string) (io.ReadCl
 func (c *Client) UpdateRowsOnSheet(sheetID string, rows []Row) (io.ReadCloser, error) {
 
 	// //the caller needs to pass in clean data right now
-	return c.PutObject(fmt.Sprintf("sheets/%v/rows", sheetID), rows)
+	body, err := c.putObjectInto(&[]Row{}, fmt.Sprintf("sheets/%v/rows", sheetID), rows)
+	if err == nil {
+		c.indexes.invalidate(sheetID)
+	}
+	return body, err
+}
+
+// updateRows calls UpdateRowsOnSheet and validates the result
+func (c *Client) updateRows(sheetID string, rows []Row) error {
+	body, err := c.UpdateRowsOnSheet(sheetID, rows)
+	if err != nil {
+		return err
+	}
+
+	var updated []Row
+	return decodeAsResultResponseInto(body, &updated)
+}
+
+// putRows will PUT the rows, which may be any payload that encodes as a list of rows, and validates the result
+func (c *Client) putRows(sheetID string, rows interface{}) error {
+	var updated []Row
+	body, err := c.putObjectInto(&updated, fmt.Sprintf("sheets/%v/rows", sheetID), rows)
+	if err != nil {
+		return err
+	}
+	c.indexes.invalidate(sheetID)
+
+	return decodeAsResultResponseInto(body, &updated)
 }
 
 func encodeData(data interface{}) (io.Reader, error) {
@@ -329,102 +424,184 @@ func encodeData(data interface{}) (io.Reader, error) {
 
 // PostObject will post data as JSOn
 func (c *Client) PostObject(path string, data interface{}) (io.ReadCloser, error) {
+	return c.postObjectInto(nil, path, data)
+}
+
+// postObjectInto will post data as JSON, target is the object the result will be decoded into
+func (c *Client) postObjectInto(target interface{}, path string, data interface{}) (io.ReadCloser, error) {
+	return c.sendObject(target, "POST", path, data)
+}
+
+// putObjectInto will put data as JSON, target is the object the result will be decoded into
+func (c *Client) putObjectInto(target interface{}, path string, data interface{}) (io.ReadCloser, error) {
+	return c.sendObject(target, "PUT", path, data)
+}
 
+// sendObject will send data as JSON through doInto
+func (c *Client) sendObject(target interface{}, verb string, path string, data interface{}) (io.ReadCloser, error) {
 	b, err := encodeData(data)
 	if err != nil {
 		return nil, errors.Wrap(err, "Cannot encode data")
 	}
 
-	if c.VerboseMode {
-		buf := b.(*bytes.Buffer)
-		log.Printf("Body:\n%v\n", string(buf.Bytes()))
-	}
-	
 	h := map[string]string{"Content-Type": "application/json"}
-	resp, statusCode, err := c.Post(path, b, h)
+	resp, _, err := c.doInto(context.Background(), target, verb, path, b, h)
+	return resp, err
+}
+
+// getObject will GET the path decoding the JSON response into v
+func (c *Client) getObject(path string, v interface{}) error {
+	return c.getObjectContext(context.Background(), path, v)
+}
+
+// getObjectContext is the same as getObject but makes the request with the context
+func (c *Client) getObjectContext(ctx context.Context, path string, v interface{}) error {
+	body, _, err := c.doInto(ctx, v, "GET", path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return decodeInto(body, v)
+}
+
+// deleteObject will DELETE the path and validate the result
+func (c *Client) deleteObject(path string) error {
+	body, _, err := c.doInto(context.Background(), &ResultResponse{}, "DELETE", path, nil, nil)
 	if err != nil {
-		return resp, err
+		return err
 	}
 
-	if statusCode != 200 {
-		return nil, ErrorItemDecodeFromReader(statusCode, resp)
+	return decodeResult(body)
+}
+
+// postAction will POST to the path without a body and validate the result, used for actions such as deactivate
+func (c *Client) postAction(path string) error {
+	body, _, err := c.doInto(context.Background(), &ResultResponse{}, "POST", path, nil, nil)
+	if err != nil {
+		return err
 	}
 
-	return resp, nil
+	return decodeResult(body)
 }
 
 // Post will send a POST request through the client
-func (c *Client) Post(path string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {	
-	return c.send("POST", path, body, additionalHeaders)
+// Unsuccessful responses are returned as an error, otherwise the caller must close the body
+func (c *Client) Post(path string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {
+	return c.PostWithContext(context.Background(), path, body, additionalHeaders)
+}
+
+// PostWithContext is the same as Post but makes the request with the context
+func (c *Client) PostWithContext(ctx context.Context, path string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {
+	return c.doInto(ctx, nil, "POST", path, body, additionalHeaders)
 }
 
 // PutObject will post data as JSON
 func (c *Client) PutObject(path string, data interface{}) (io.ReadCloser, error) {
-
-	b, err := encodeData(data)
-	if err != nil {
-		return nil, errors.Wrap(err, "Cannot encode data")
-	}
-	
-	h := map[string]string{"Content-Type": "application/json"}
-	resp, statusCode, err := c.Put(path, b, h)
-	if err != nil {
-		return resp, err
-	}
-
-	if statusCode != 200 {
-		return nil, ErrorItemDecodeFromReader(statusCode, resp)
-	}
-
-	return resp, nil
+	return c.putObjectInto(nil, path, data)
 }
 
 // Put will send a PUT request through the client
-func (c *Client) Put(path string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {	
-	return c.send("PUT", path, body, additionalHeaders)
+// Unsuccessful responses are returned as an error, otherwise the caller must close the body
+func (c *Client) Put(path string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {
+	return c.PutWithContext(context.Background(), path, body, additionalHeaders)
+}
+
+// PutWithContext is the same as Put but makes the request with the context
+func (c *Client) PutWithContext(ctx context.Context, path string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {
+	return c.doInto(ctx, nil, "PUT", path, body, additionalHeaders)
 }
 
 // Delete will send a DELETE request through the client
+// Unsuccessful responses are returned as an error, otherwise the caller must close the body
 func (c *Client) Delete(path string) (io.ReadCloser, int, error) {
-	return c.send("DELETE", path, nil, nil)
+	return c.DeleteWithContext(context.Background(), path)
+}
+
+// DeleteWithContext is the same as Delete but makes the request with the context
+func (c *Client) DeleteWithContext(ctx context.Context, path string) (io.ReadCloser, int, error) {
+	return c.doInto(ctx, nil, "DELETE", path, nil, nil)
 }
 
 // Get will append the proper info to pull from the API
+// Unsuccessful responses are returned as an error, otherwise the caller must close the body
 func (c *Client) Get(path string) (io.ReadCloser, int, error) {
-	return c.send("GET", path, nil, nil)
+	return c.GetWithContext(context.Background(), path)
+}
+
+// GetWithContext is the same as Get but makes the request with the context.  Options within the context
+// from ContextWithOptions are applied after those of the client.
+func (c *Client) GetWithContext(ctx context.Context, path string) (io.ReadCloser, int, error) {
+	return c.doInto(ctx, nil, "GET", path, nil, nil)
+}
+
+// doInto sends the request through the middleware chain, target is the object the response will be decoded into
+func (c *Client) doInto(ctx context.Context, target interface{}, verb string, p string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {
+	req, err := c.newRequest(ctx, verb, p, body, additionalHeaders)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r := &Request{HTTP: req, Verb: verb, Path: p, Attempt: 1}
+	if target != nil {
+		r.Target = reflect.TypeOf(target)
+	}
+
+	resp, err := c.handler()(r)
+	if resp == nil {
+		return nil, 0, err
+	}
+
+	return resp.Body, resp.StatusCode, err
 }
 
-func (c *Client) send(verb string, p string, body io.Reader, additionalHeaders map[string]string) (io.ReadCloser, int, error) {
+// newRequest creates the request for the path adding the options and headers, credentials are added by send
+func (c *Client) newRequest(ctx context.Context, verb string, p string, body io.Reader, additionalHeaders map[string]string) (*http.Request, error) {
 	var fullPath = c.url + "/" + p
 
 	//validate URL
 	_, err := validateURL(fullPath)
 	if err != nil {
-		return nil, 0, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
-	req, err := http.NewRequest(verb, fullPath, body)
+	req, err := http.NewRequestWithContext(ctx, verb, fullPath, body)
 
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Failed to create %v request", verb)
+		return nil, errors.Wrapf(err, "Failed to create %v request", verb)
 	}
 
-	if c.VerboseMode {
-		log.Printf("URL: %v\n", req.URL)
-	}
-
-	req.Header.Add("Authorization", "Bearer "+c.apiKey)
+	applyRequestOptions(req, c.options)
 
 	if additionalHeaders != nil {
 		for k, v := range additionalHeaders {
-			req.Header.Add(k, v)
+			req.Header.Set(k, v)
 		}
 	}
 
-	resp, err := c.client.Do(req)
+	//the transport only honours the length on the request itself, which is required when streaming uploads
+	if cl := req.Header.Get("Content-Length"); cl != "" {
+		if req.ContentLength, err = strconv.ParseInt(cl, 10, 64); err != nil {
+			return nil, errors.Wrapf(err, "Invalid Content-Length '%v'", cl)
+		}
+	}
+
+	return req, nil
+}
+
+// send is the innermost Handler of the chain.  The credentials are added to every attempt so a changed
+// token is picked up by retries, and unsuccessful responses are returned as an *ErrorItem.
+func (c *Client) send(req *Request) (*RequestResult, error) {
+	token, err := c.bearer(req.HTTP.Context())
+	if err != nil {
+		return nil, err
+	}
+	req.HTTP.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req.HTTP)
 	if err != nil {
-		return nil, 0, errors.Wrapf(err, "Failed to %v", verb)
+		return nil, errors.Wrapf(err, "Failed to %v", req.Verb)
 	}
 
-	return resp.Body, resp.StatusCode, nil
+	body, err := checkResponse(resp.StatusCode, resp.Body)
+	return &RequestResult{StatusCode: resp.StatusCode, Header: resp.Header, Body: body}, err
 }
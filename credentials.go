@@ -0,0 +1,143 @@
+package goSmartSheet
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultAccessTokenEnv is the environment variable read by EnvCredentials when no name is set
+const DefaultAccessTokenEnv = "SMARTSHEET_ACCESS_TOKEN"
+
+// CredentialProvider supplies the access token sent as the bearer token.
+// It is consulted for every request attempt, so a changed token is used by the next attempt,
+// including retries of a request already in flight.
+type CredentialProvider interface {
+	AccessToken(ctx context.Context) (string, error)
+}
+
+// StaticCredentials is a fixed access token
+type StaticCredentials string
+
+// AccessToken returns the token
+func (s StaticCredentials) AccessToken(ctx context.Context) (string, error) {
+	if s == "" {
+		return "", errors.New("Access token is blank")
+	}
+	return string(s), nil
+}
+
+// EnvCredentials reads the access token from an environment variable on each request
+type EnvCredentials struct {
+	//Name of the environment variable, defaults to DefaultAccessTokenEnv
+	Name string
+}
+
+// AccessToken returns the value of the environment variable
+func (e EnvCredentials) AccessToken(ctx context.Context) (string, error) {
+	name := e.Name
+	if name == "" {
+		name = DefaultAccessTokenEnv
+	}
+
+	t := os.Getenv(name)
+	if t == "" {
+		return "", errors.Errorf("Environment variable %v is not set", name)
+	}
+	return t, nil
+}
+
+// FileCredentials reads the access token from a file, which is reloaded whenever it changes.
+// Leading and trailing whitespace is ignored.
+type FileCredentials struct {
+	Path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileCredentials returns a CredentialProvider for the token within the file
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{Path: path}
+}
+
+// AccessToken returns the token within the file, reading it again when it has changed since the last request
+func (f *FileCredentials) AccessToken(ctx context.Context) (string, error) {
+	fi, err := os.Stat(f.Path)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to read access token from %v", f.Path)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.token != "" && fi.ModTime().Equal(f.modTime) && fi.Size() == f.size {
+		return f.token, nil
+	}
+
+	b, err := os.ReadFile(f.Path)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to read access token from %v", f.Path)
+	}
+
+	t := string(bytes.TrimSpace(b))
+	if t == "" {
+		return "", errors.Errorf("Access token file %v is empty", f.Path)
+	}
+
+	f.token, f.modTime, f.size = t, fi.ModTime(), fi.Size()
+	return t, nil
+}
+
+type tokenSourceCredentials struct {
+	ts TokenSource
+}
+
+// TokenSourceCredentials returns a CredentialProvider supplying the access token of the TokenSource
+func TokenSourceCredentials(ts TokenSource) CredentialProvider {
+	return tokenSourceCredentials{ts}
+}
+
+func (t tokenSourceCredentials) AccessToken(ctx context.Context) (string, error) {
+	tok, err := t.ts.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	return tok.AccessToken, nil
+}
+
+// GetClientWithCredentials returns a client which asks the provider for the access token of each request
+//
+//	client, err := GetClientWithCredentials(EnvCredentials{}, "")
+func GetClientWithCredentials(p CredentialProvider, u string) (*Client, error) {
+	if p == nil {
+		return nil, errors.New("CredentialProvider must be provided")
+	}
+
+	c, err := newClient(u)
+	if err != nil {
+		return nil, err
+	}
+
+	c.credentials = p
+	return c, nil
+}
+
+// bearer returns the bearer token for a request
+func (c *Client) bearer(ctx context.Context) (string, error) {
+	if c.credentials == nil {
+		return "", errors.New("Client has no credentials")
+	}
+
+	t, err := c.credentials.AccessToken(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to get access token")
+	}
+	return t, nil
+}
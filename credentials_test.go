@@ -0,0 +1,60 @@
+package goSmartSheet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileCredentials(t *testing.T) {
+	assert := assert.New(t)
+
+	var bearers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearers = append(bearers, r.Header.Get("Authorization"))
+		io.WriteString(w, `{"id":1}`)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "token")
+	assert.NoError(os.WriteFile(path, []byte("first\n"), 0600))
+
+	c, err := GetClientWithCredentials(NewFileCredentials(path), srv.URL+"/2.0")
+	assert.NoError(err)
+
+	_, err = c.GetSheet("1", "")
+	assert.NoError(err)
+
+	assert.NoError(os.WriteFile(path, []byte("second-token"), 0600))
+	later := time.Now().Add(time.Second)
+	assert.NoError(os.Chtimes(path, later, later))
+
+	_, err = c.GetSheet("1", "")
+	assert.NoError(err)
+	assert.Equal([]string{"Bearer first", "Bearer second-token"}, bearers)
+
+	assert.NoError(os.Remove(path))
+	_, err = c.GetSheet("1", "")
+	assert.Error(err)
+	assert.Len(bearers, 2)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Setenv(DefaultAccessTokenEnv, "")
+	_, err := EnvCredentials{}.AccessToken(context.Background())
+	assert.Error(err)
+
+	t.Setenv(DefaultAccessTokenEnv, "env-token")
+	tok, err := EnvCredentials{}.AccessToken(context.Background())
+	assert.NoError(err)
+	assert.Equal("env-token", tok)
+}
@@ -0,0 +1,341 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DiffOptions controls how desired rows are matched to the rows of the current sheet
+type DiffOptions struct {
+	// KeyColumnID matches rows by the value within this column.  When 0, rows are matched by Row.ID
+	// and desired rows without an ID are treated as adds.
+	KeyColumnID int64
+	// KeepUnmatched will not delete rows within the sheet that are missing from the desired rows
+	KeepUnmatched bool
+	// IgnoreOrder will not produce moves when the desired order differs from the sheet
+	IgnoreOrder bool
+}
+
+// CellChange is a single cell that differs between the sheet and the desired row
+type CellChange struct {
+	ColumnID int64
+	Old      *CellValue
+	New      *CellValue
+}
+
+// RowUpdate contains the changed cells for an existing row
+type RowUpdate struct {
+	RowID   int64
+	Changes []CellChange
+}
+
+// RowMove moves a run of rows, in order, directly below SiblingID or to the top of the sheet when SiblingID is 0
+type RowMove struct {
+	RowIDs    []int64
+	SiblingID int64
+}
+
+// DiffPlan is the minimal set of operations required to turn a sheet into the desired rows
+type DiffPlan struct {
+	Adds    []Row
+	Updates []RowUpdate
+	Deletes []int64
+	Moves   []RowMove
+	// Skipped are the rows within the sheet without a value in the key column, which are never changed
+	Skipped []int64
+
+	titles map[int64]string
+}
+
+// Diff compares the desired rows to the current sheet and returns the plan to make the sheet match.
+// Cell values are compared using CellValue.Equal so an int and float of the same value are not considered a change.
+// Cells without a ColumnID are assigned the column at the same position.
+// Rows within the sheet without a key are reported within Skipped and are never deleted.
+// Moves assume a flat sheet, parent / child relationships are not considered.
+func Diff(current *Sheet, desired []Row, opt DiffOptions) (*DiffPlan, error) {
+	p := &DiffPlan{titles: make(map[int64]string, len(current.Columns))}
+	for _, col := range current.Columns {
+		p.titles[col.ID] = col.Title
+	}
+
+	keyOf := func(r *Row) string {
+		if opt.KeyColumnID == 0 {
+			if r.ID == 0 {
+				return ""
+			}
+			return strconv.FormatInt(r.ID, 10)
+		}
+
+		for i := range r.Cells {
+			if r.Cells[i].ColumnID == opt.KeyColumnID {
+				return r.Cells[i].Value.normalized()
+			}
+		}
+		return ""
+	}
+
+	//index the current rows, rows without a key cannot be matched so they are left alone rather than deleted
+	currentIdx := make(map[string]int, len(current.Rows))
+	skipped := make(map[int]bool)
+	for i := range current.Rows {
+		k := keyOf(&current.Rows[i])
+		if k == "" {
+			skipped[i] = true
+			p.Skipped = append(p.Skipped, current.Rows[i].ID)
+			continue
+		}
+
+		if _, exists := currentIdx[k]; exists {
+			return nil, errors.Errorf("Duplicate key '%v' in sheet", k)
+		}
+		currentIdx[k] = i
+	}
+
+	matched := make(map[int]bool, len(desired))
+	var positions []int //current position of each matched row in desired order
+	for i := range desired {
+		d := desired[i]
+		d.Cells = append([]Cell(nil), d.Cells...)
+		for j := range d.Cells {
+			if d.Cells[j].ColumnID == 0 {
+				if j >= len(current.Columns) {
+					return nil, errors.New("Cells within a row cannot be greater than the columns within the sheet")
+				}
+				d.Cells[j].ColumnID = current.Columns[j].ID
+			}
+		}
+
+		k := keyOf(&d)
+		pos, exists := currentIdx[k]
+		if k == "" || !exists {
+			if opt.KeyColumnID == 0 && d.ID != 0 {
+				return nil, errors.Errorf("Row %v does not exist in sheet", d.ID)
+			}
+			if opt.KeyColumnID != 0 && k == "" {
+				return nil, errors.Errorf("Row %v is missing a value for the key column", i)
+			}
+
+			d.ID = 0
+			p.Adds = append(p.Adds, d)
+			continue
+		}
+
+		if matched[pos] {
+			return nil, errors.Errorf("Duplicate key '%v' in desired rows", k)
+		}
+		matched[pos] = true
+		positions = append(positions, pos)
+
+		cur := &current.Rows[pos]
+		curCells := make(map[int64]*CellValue, len(cur.Cells))
+		for j := range cur.Cells {
+			curCells[cur.Cells[j].ColumnID] = cur.Cells[j].Value
+		}
+
+		u := RowUpdate{RowID: cur.ID}
+		for _, c := range d.Cells {
+			if old := curCells[c.ColumnID]; !c.Value.Equal(old) {
+				u.Changes = append(u.Changes, CellChange{ColumnID: c.ColumnID, Old: old, New: c.Value})
+			}
+		}
+
+		if len(u.Changes) > 0 {
+			p.Updates = append(p.Updates, u)
+		}
+	}
+
+	if !opt.KeepUnmatched {
+		for i := range current.Rows {
+			if !matched[i] && !skipped[i] {
+				p.Deletes = append(p.Deletes, current.Rows[i].ID)
+			}
+		}
+	}
+
+	if !opt.IgnoreOrder {
+		p.Moves = diffMoves(current, positions)
+	}
+
+	return p, nil
+}
+
+// diffMoves returns the moves needed to put the rows at the specified positions into order.
+// Rows within the longest increasing run of positions stay where they are, everything else is moved
+// below the row that precedes it in the desired order.
+func diffMoves(current *Sheet, positions []int) (moves []RowMove) {
+	keep := longestIncreasing(positions)
+
+	var run *RowMove
+	for i, pos := range positions {
+		if keep[i] {
+			run = nil
+			continue
+		}
+
+		if run == nil {
+			m := RowMove{}
+			if i > 0 {
+				m.SiblingID = current.Rows[positions[i-1]].ID
+			}
+			moves = append(moves, m)
+			run = &moves[len(moves)-1]
+		}
+		run.RowIDs = append(run.RowIDs, current.Rows[pos].ID)
+	}
+
+	return
+}
+
+// longestIncreasing flags the members of a longest strictly increasing subsequence of vals
+func longestIncreasing(vals []int) []bool {
+	tails := []int{}               //index into vals of the smallest tail for each length
+	prev := make([]int, len(vals)) //predecessor index within the subsequence
+	for i, v := range vals {
+		n := sort.Search(len(tails), func(j int) bool { return vals[tails[j]] >= v })
+		if n > 0 {
+			prev[i] = tails[n-1]
+		} else {
+			prev[i] = -1
+		}
+
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+
+	keep := make([]bool, len(vals))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			keep[i] = true
+		}
+	}
+
+	return keep
+}
+
+// Empty returns true when the plan contains no changes
+func (p *DiffPlan) Empty() bool {
+	return len(p.Adds) == 0 && len(p.Updates) == 0 && len(p.Deletes) == 0 && len(p.Moves) == 0
+}
+
+func (p *DiffPlan) title(id int64) string {
+	if t, exists := p.titles[id]; exists {
+		return t
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// String returns a human readable report of the plan
+func (p *DiffPlan) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "%v adds, %v updates, %v deletes, %v moves\n", len(p.Adds), len(p.Updates), len(p.Deletes), len(p.Moves))
+
+	for _, r := range p.Adds {
+		vals := make([]string, len(r.Cells))
+		for i, c := range r.Cells {
+			vals[i] = fmt.Sprintf("%v: '%v'", p.title(c.ColumnID), c.Value.normalized())
+		}
+		fmt.Fprintf(b, "+ add row {%v}\n", strings.Join(vals, ", "))
+	}
+
+	for _, u := range p.Updates {
+		fmt.Fprintf(b, "~ update row %v\n", u.RowID)
+		for _, c := range u.Changes {
+			fmt.Fprintf(b, "    %v: '%v' -> '%v'\n", p.title(c.ColumnID), c.Old.normalized(), c.New.normalized())
+		}
+	}
+
+	for _, id := range p.Deletes {
+		fmt.Fprintf(b, "- delete row %v\n", id)
+	}
+
+	for _, id := range p.Skipped {
+		fmt.Fprintf(b, "? skipped row %v without a key\n", id)
+	}
+
+	for _, m := range p.Moves {
+		if m.SiblingID == 0 {
+			fmt.Fprintf(b, "> move rows %v to top\n", m.RowIDs)
+		} else {
+			fmt.Fprintf(b, "> move rows %v below row %v\n", m.RowIDs, m.SiblingID)
+		}
+	}
+
+	return b.String()
+}
+
+// rowMove is the payload of a moved row, which only contains the location
+type rowMove struct {
+	ID        int64 `json:"id"`
+	SiblingID int64 `json:"siblingId,omitempty"`
+	ToTop     bool  `json:"toTop,omitempty"`
+}
+
+// ApplyDiff will apply the plan to the sheet.  Deletes are applied first, followed by updates, moves and finally adds
+// which are placed at the bottom of the sheet.
+func (c *Client) ApplyDiff(sheetID string, p *DiffPlan) error {
+	if len(p.Deletes) > 0 {
+		ids := make([]string, len(p.Deletes))
+		for i, id := range p.Deletes {
+			ids[i] = strconv.FormatInt(id, 10)
+		}
+
+		body, _, err := c.DeleteRowsIdsFromSheet(sheetID, ids)
+		if err == nil {
+			err = decodeResult(body)
+		}
+		if err != nil {
+			return errors.Wrap(err, "Failed to delete rows")
+		}
+	}
+
+	if len(p.Updates) > 0 {
+		rows := make([]Row, len(p.Updates))
+		for i, u := range p.Updates {
+			rows[i].ID = u.RowID
+			for _, change := range u.Changes {
+				v := change.New
+				if v == nil {
+					v = &CellValue{}
+					v.SetString("") //clear the cell
+				}
+				rows[i].Cells = append(rows[i].Cells, Cell{ColumnID: change.ColumnID, Value: v})
+			}
+		}
+
+		if err := c.updateRows(sheetID, rows); err != nil {
+			return errors.Wrap(err, "Failed to update rows")
+		}
+	}
+
+	for _, m := range p.Moves {
+		rows := make([]rowMove, len(m.RowIDs))
+		for i, id := range m.RowIDs {
+			rows[i] = rowMove{ID: id, SiblingID: m.SiblingID, ToTop: m.SiblingID == 0}
+		}
+
+		if err := c.putRows(sheetID, rows); err != nil {
+			return errors.Wrap(err, "Failed to move rows")
+		}
+	}
+
+	if len(p.Adds) > 0 {
+		body, err := c.AddRowsToSheet(sheetID, ToBottom, p.Adds, NormalValidation)
+		if err != nil {
+			return errors.Wrap(err, "Failed to add rows")
+		}
+
+		var added []Row
+		if err = decodeAsResultResponseInto(body, &added); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
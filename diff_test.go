@@ -0,0 +1,128 @@
+package goSmartSheet
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func diffRow(id int64, key string, val int) Row {
+	var kv, vv CellValue
+	kv.SetString(key)
+	vv.SetInt(val)
+	return Row{ID: id, Cells: []Cell{{ColumnID: 1, Value: &kv}, {ColumnID: 2, Value: &vv}}}
+}
+
+func diffSheet() *Sheet {
+	return &Sheet{
+		Columns: []Column{{ID: 1, Title: "Key"}, {ID: 2, Title: "Value"}},
+		Rows:    []Row{diffRow(10, "a", 1), diffRow(11, "b", 2), diffRow(12, "c", 3), diffRow(13, "d", 4)},
+	}
+}
+
+func TestDiff_ByKey(t *testing.T) {
+	assert := assert.New(t)
+
+	//d moves to the top, b is deleted, c changes value and e is added
+	desired := []Row{diffRow(0, "d", 4), diffRow(0, "a", 1), diffRow(0, "c", 30), diffRow(0, "e", 5)}
+	p, err := Diff(diffSheet(), desired, DiffOptions{KeyColumnID: 1})
+	assert.NoError(err)
+
+	assert.Len(p.Adds, 1)
+	assert.Equal("e", p.Adds[0].Cells[0].Value.String())
+	assert.Equal([]int64{11}, p.Deletes)
+	assert.Len(p.Updates, 1)
+	assert.Equal(int64(12), p.Updates[0].RowID)
+	assert.Len(p.Updates[0].Changes, 1)
+	assert.Equal(int64(2), p.Updates[0].Changes[0].ColumnID)
+	assert.Equal([]RowMove{{RowIDs: []int64{13}}}, p.Moves)
+
+	assert.Contains(p.String(), "Value: '3' -> '30'")
+	assert.Contains(p.String(), "> move rows [13] to top")
+}
+
+func TestDiff_ByID(t *testing.T) {
+	assert := assert.New(t)
+
+	//floats that match the existing int values are not changes
+	var f CellValue
+	f.SetFloat(2)
+	b := diffRow(11, "b", 0)
+	b.Cells[1].Value = &f
+
+	desired := []Row{diffRow(10, "a", 1), diffRow(12, "c", 3), b}
+	p, err := Diff(diffSheet(), desired, DiffOptions{KeepUnmatched: true})
+	assert.NoError(err)
+	assert.Empty(p.Updates)
+	assert.Empty(p.Deletes)
+	assert.Equal([]RowMove{{RowIDs: []int64{12}, SiblingID: 10}}, p.Moves)
+
+	p, err = Diff(diffSheet(), []Row{diffRow(10, "a", 1), diffRow(11, "b", 2), diffRow(12, "c", 3), diffRow(13, "d", 4)}, DiffOptions{})
+	assert.NoError(err)
+	assert.True(p.Empty())
+
+	_, err = Diff(diffSheet(), []Row{diffRow(99, "z", 1)}, DiffOptions{})
+	assert.Error(err)
+}
+
+func TestDiff_BlankKeysAreSkipped(t *testing.T) {
+	assert := assert.New(t)
+
+	s := diffSheet()
+	s.Rows = append(s.Rows, Row{ID: 14, Cells: []Cell{{ColumnID: 2, Value: s.Rows[0].Cells[1].Value}}})
+
+	desired := []Row{diffRow(0, "a", 1), diffRow(0, "b", 2), diffRow(0, "c", 3), diffRow(0, "d", 4)}
+	p, err := Diff(s, desired, DiffOptions{KeyColumnID: 1})
+	assert.NoError(err)
+	assert.Empty(p.Deletes)
+	assert.Equal([]int64{14}, p.Skipped)
+	assert.Contains(p.String(), "? skipped row 14 without a key")
+}
+
+func TestClient_ApplyDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" "+strings.TrimSpace(string(b)))
+		io.WriteString(w, `{"resultCode":0,"result":[]}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	desired := []Row{diffRow(0, "d", 4), diffRow(0, "a", 1), diffRow(0, "c", 30), diffRow(0, "e", 5)}
+	p, err := Diff(diffSheet(), desired, DiffOptions{KeyColumnID: 1})
+	assert.NoError(err)
+	p.Updates[0].Changes = append(p.Updates[0].Changes, CellChange{ColumnID: 1})
+
+	assert.NoError(c.ApplyDiff("1", p))
+	assert.Equal([]string{
+		"DELETE /2.0/sheets/1/rows?ids=11 ",
+		`PUT /2.0/sheets/1/rows [{"id":12,"cells":[{"columnId":2,"value":30},{"columnId":1,"value":""}]}]`,
+		`PUT /2.0/sheets/1/rows [{"id":13,"toTop":true}]`,
+		`POST /2.0/sheets/1/rows [{"cells":[{"columnId":1,"value":"e"},{"columnId":2,"value":5}],"toBottom":true}]`,
+	}, requests)
+
+	//nothing is sent for an empty plan
+	requests = nil
+	assert.NoError(c.ApplyDiff("1", &DiffPlan{}))
+	assert.Empty(requests)
+
+	//later batches are not sent once one fails
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"errorCode":1008,"message":"Unable to parse request"}`)
+	})
+	err = c.ApplyDiff("1", p)
+	assert.Error(err)
+	assert.Contains(err.Error(), "Failed to delete rows")
+	assert.Equal([]string{"DELETE"}, requests)
+}
@@ -0,0 +1,136 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Discussion is a collection of comments on a sheet or row
+// https://smartsheet-platform.github.io/api-docs/#discussion-object
+type Discussion struct {
+	ID                 int64        `json:"id,omitempty"`
+	Title              string       `json:"title,omitempty"`
+	Comments           []Comment    `json:"comments,omitempty"`
+	CommentCount       int          `json:"commentCount,omitempty"`
+	CommentAttachments []Attachment `json:"commentAttachments,omitempty"`
+	AccessLevel        string       `json:"accessLevel,omitempty"`
+	ParentID           int64        `json:"parentId,omitempty"`
+	ParentType         string       `json:"parentType,omitempty"`
+	ReadOnly           bool         `json:"readOnly,omitempty"`
+	LastCommentedAt    *time.Time   `json:"lastCommentedAt,omitempty"`
+	LastCommentedUser  *MiniUser    `json:"lastCommentedUser,omitempty"`
+	CreatedBy          *MiniUser    `json:"createdBy,omitempty"`
+
+	//Comment is only used when creating a discussion
+	Comment *Comment `json:"comment,omitempty"`
+}
+
+// Comment is a single entry within a Discussion
+// https://smartsheet-platform.github.io/api-docs/#comment-object
+type Comment struct {
+	ID           int64        `json:"id,omitempty"`
+	DiscussionID int64        `json:"discussionId,omitempty"`
+	Text         string       `json:"text"`
+	Attachments  []Attachment `json:"attachments,omitempty"`
+	CreatedBy    *MiniUser    `json:"createdBy,omitempty"`
+	CreatedAt    *time.Time   `json:"createdAt,omitempty"`
+	ModifiedAt   *time.Time   `json:"modifiedAt,omitempty"`
+}
+
+// ListDiscussions returns every discussion on the sheet, including row discussions, with their comments and attachments
+func (c *Client) ListDiscussions(sheetID string) ([]Discussion, error) {
+	return getAllPages[Discussion](c, fmt.Sprintf("sheets/%v/discussions?include=comments,attachments", sheetID))
+}
+
+// ListRowDiscussions returns the discussions on the row with their comments and attachments
+func (c *Client) ListRowDiscussions(sheetID string, rowID int64) ([]Discussion, error) {
+	return getAllPages[Discussion](c, fmt.Sprintf("sheets/%v/rows/%v/discussions?include=comments,attachments", sheetID, rowID))
+}
+
+// GetDiscussion returns the discussion with all of its comments
+func (c *Client) GetDiscussion(sheetID string, discussionID int64) (*Discussion, error) {
+	d := &Discussion{}
+	if err := c.getObject(fmt.Sprintf("sheets/%v/discussions/%v", sheetID, discussionID), d); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get discussion (ID: %v)", discussionID)
+	}
+
+	return d, nil
+}
+
+// CreateDiscussion starts a new discussion on the sheet with text as its first comment
+func (c *Client) CreateDiscussion(sheetID, title, text string) (*Discussion, error) {
+	d := Discussion{Title: title, Comment: &Comment{Text: text}}
+	return c.createDiscussion(fmt.Sprintf("sheets/%v/discussions", sheetID), d)
+}
+
+// CreateRowDiscussion starts a new discussion on the row with text as its first comment
+func (c *Client) CreateRowDiscussion(sheetID string, rowID int64, text string) (*Discussion, error) {
+	d := Discussion{Comment: &Comment{Text: text}}
+	return c.createDiscussion(fmt.Sprintf("sheets/%v/rows/%v/discussions", sheetID, rowID), d)
+}
+
+func (c *Client) createDiscussion(path string, d Discussion) (*Discussion, error) {
+	created := &Discussion{}
+	body, err := c.postObjectInto(created, path, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// DeleteDiscussion removes the discussion and all of its comments
+func (c *Client) DeleteDiscussion(sheetID string, discussionID int64) error {
+	return c.deleteObject(fmt.Sprintf("sheets/%v/discussions/%v", sheetID, discussionID))
+}
+
+// GetComment returns a single comment
+func (c *Client) GetComment(sheetID string, commentID int64) (*Comment, error) {
+	cm := &Comment{}
+	if err := c.getObject(fmt.Sprintf("sheets/%v/comments/%v", sheetID, commentID), cm); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get comment (ID: %v)", commentID)
+	}
+
+	return cm, nil
+}
+
+// AddComment adds a comment to the end of an existing discussion
+func (c *Client) AddComment(sheetID string, discussionID int64, text string) (*Comment, error) {
+	cm := &Comment{}
+	body, err := c.postObjectInto(cm, fmt.Sprintf("sheets/%v/discussions/%v/comments", sheetID, discussionID), Comment{Text: text})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, cm); err != nil {
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+// EditComment replaces the text of a comment.  Only the author of the comment can edit it.
+func (c *Client) EditComment(sheetID string, commentID int64, text string) (*Comment, error) {
+	cm := &Comment{}
+	body, err := c.putObjectInto(cm, fmt.Sprintf("sheets/%v/comments/%v", sheetID, commentID), Comment{Text: text})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, cm); err != nil {
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+// DeleteComment removes a single comment
+func (c *Client) DeleteComment(sheetID string, commentID int64) error {
+	return c.deleteObject(fmt.Sprintf("sheets/%v/comments/%v", sheetID, commentID))
+}
@@ -0,0 +1,61 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Discussions(t *testing.T) {
+	assert := assert.New(t)
+
+	var created Discussion
+	var edited Comment
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/2.0/sheets/1":
+			assert.Equal("include=discussions", r.URL.RawQuery)
+			io.WriteString(w, `{"id":1,
+				"discussions":[{"id":20,"title":"Sheet","comments":[{"id":30,"text":"hi","createdBy":{"name":"Bob"}}]}],
+				"rows":[{"id":10,"discussions":[{"id":21,"parentId":10,"parentType":"ROW","commentCount":1}]}]}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/sheets/1/rows/10/discussions":
+			json.NewDecoder(r.Body).Decode(&created)
+			io.WriteString(w, `{"resultCode":0,"result":{"id":22,"comments":[{"id":31,"text":"new"}]}}`)
+		case r.Method == "PUT" && r.URL.Path == "/2.0/sheets/1/comments/31":
+			json.NewDecoder(r.Body).Decode(&edited)
+			io.WriteString(w, `{"resultCode":0,"result":{"id":31,"text":"edited"}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/2.0/sheets/1/comments/31":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	s, err := c.GetSheet("1", "include=discussions")
+	assert.NoError(err)
+	assert.Len(s.Discussions, 1)
+	assert.Equal("Bob", s.Discussions[0].Comments[0].CreatedBy.Name)
+	assert.Equal(int64(21), s.Rows[0].Discussions[0].ID)
+
+	d, err := c.CreateRowDiscussion("1", 10, "new")
+	assert.NoError(err)
+	assert.Equal(int64(22), d.ID)
+	assert.Equal("new", created.Comment.Text)
+
+	cm, err := c.EditComment("1", 31, "edited")
+	assert.NoError(err)
+	assert.Equal("edited", cm.Text)
+	assert.Equal("edited", edited.Text)
+
+	assert.NoError(c.DeleteComment("1", 31))
+	assert.Error(c.DeleteComment("1", 32))
+}
@@ -0,0 +1,365 @@
+package goSmartSheet
+
+import (
+	"container/list"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EnrichedEvent is a webhook event together with the data of the row it changed
+type EnrichedEvent struct {
+	WebhookEvent
+	SheetID int64
+
+	// Row is the current row, nil for deleted rows and events not related to rows
+	Row *Row
+	// Cell is the current cell within Row for cell events
+	Cell *Cell
+	// OldRow and OldCell are the previous values when a cached copy of the row exists
+	OldRow  *Row
+	OldCell *Cell
+
+	// Err is populated when the row data could not be fetched
+	Err error
+}
+
+// EnricherOptions controls how an Enricher batches callbacks
+type EnricherOptions struct {
+	// BatchWindow is how long events are collected before rows are fetched, defaults to 1 second
+	BatchWindow time.Duration
+	// MaxBatch is the maximum number of events per sheet before rows are fetched immediately, defaults to 100
+	MaxBatch int
+	// Buffer is the number of callbacks and enriched events that can be queued, defaults to 100
+	Buffer int
+	// MaxCachedRows is the number of rows, across every sheet, kept to provide OldRow and OldCell.
+	// The least recently changed rows are evicted first, defaults to 10000.
+	MaxCachedRows int
+}
+
+// Enricher turns the IDs within webhook callbacks into the data that changed.  Events are batched per sheet,
+// the changed rows are fetched with GetSheet filtered to the row and column IDs and the enriched events are
+// delivered on the Events channel.  Events of a sheet are delivered in the order they were received, events of
+// different sheets within the same batch window are delivered one sheet at a time.
+//
+//	e := NewEnricher(client, EnricherOptions{})
+//	handler.OnCallback(e.HandleCallback)
+//	go e.Run(ctx)
+//	for ev := range e.Events() { ... }
+type Enricher struct {
+	client *Client
+	opt    EnricherOptions
+	in     chan *WebhookCallback
+	out    chan EnrichedEvent
+
+	mu      sync.Mutex
+	cache   *rowCache
+	started bool
+}
+
+// NewEnricher returns an Enricher that fetches rows through the client
+func NewEnricher(c *Client, opt EnricherOptions) *Enricher {
+	if opt.BatchWindow <= 0 {
+		opt.BatchWindow = time.Second
+	}
+	if opt.MaxBatch <= 0 {
+		opt.MaxBatch = 100
+	}
+	if opt.Buffer <= 0 {
+		opt.Buffer = 100
+	}
+	if opt.MaxCachedRows <= 0 {
+		opt.MaxCachedRows = 10000
+	}
+
+	return &Enricher{
+		client: c,
+		opt:    opt,
+		in:     make(chan *WebhookCallback, opt.Buffer),
+		out:    make(chan EnrichedEvent, opt.Buffer),
+		cache:  newRowCache(opt.MaxCachedRows),
+	}
+}
+
+// Events returns the channel enriched events are delivered on.  It is closed when Run returns.
+func (e *Enricher) Events() <-chan EnrichedEvent {
+	return e.out
+}
+
+// Seed caches the rows of a loaded sheet so the first change to each row includes its old values
+func (e *Enricher) Seed(s *Sheet) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for i := range s.Rows {
+		e.cacheRow(s.ID, &s.Rows[i])
+	}
+}
+
+// Submit queues a callback, blocking until there is room or the context is done
+func (e *Enricher) Submit(ctx context.Context, cb *WebhookCallback) error {
+	select {
+	case e.in <- cb:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// HandleCallback queues a callback without blocking and can be registered with WebhookHandler.OnCallback.
+// An error is returned when the queue is full so SmartSheet retries the callback later.
+func (e *Enricher) HandleCallback(cb *WebhookCallback) error {
+	if len(cb.Events) == 0 {
+		return nil
+	}
+
+	select {
+	case e.in <- cb:
+		return nil
+	default:
+		return errors.New("Enricher queue is full")
+	}
+}
+
+type enrichBatch struct {
+	sheetID int64
+	events  []WebhookEvent
+}
+
+// Run processes callbacks until the context is done, at which point any queued events are dropped.
+// Rows are fetched with ctx, so cancelling it also aborts a fetch in progress.
+// Run can only be called once as the Events channel is closed when it returns.
+func (e *Enricher) Run(ctx context.Context) error {
+	e.mu.Lock()
+	started := e.started
+	e.started = true
+	e.mu.Unlock()
+
+	if started {
+		return errors.New("Enricher is already running or has stopped")
+	}
+	defer close(e.out)
+
+	var batches []*enrichBatch
+	var timer *time.Timer
+	var timeout <-chan time.Time
+
+	flush := func() error {
+		if timer != nil {
+			timer.Stop()
+			timer, timeout = nil, nil
+		}
+
+		for _, b := range batches {
+			for _, ev := range e.enrich(ctx, b.sheetID, b.events) {
+				select {
+				case e.out <- ev:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+		batches = nil
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			if err := flush(); err != nil {
+				return err
+			}
+		case cb := <-e.in:
+			var b *enrichBatch
+			for _, existing := range batches {
+				if existing.sheetID == cb.ScopeObjectID {
+					b = existing
+					break
+				}
+			}
+			if b == nil {
+				b = &enrichBatch{sheetID: cb.ScopeObjectID}
+				batches = append(batches, b)
+			}
+			b.events = append(b.events, cb.Events...)
+
+			if len(b.events) >= e.opt.MaxBatch {
+				if err := flush(); err != nil {
+					return err
+				}
+			} else if timer == nil {
+				timer = time.NewTimer(e.opt.BatchWindow)
+				timeout = timer.C
+			}
+		}
+	}
+}
+
+// enrich fetches the rows changed by the events and builds the enriched events in order
+func (e *Enricher) enrich(ctx context.Context, sheetID int64, events []WebhookEvent) []EnrichedEvent {
+	rowIDs := map[int64]bool{}
+	colIDs := map[int64]bool{}
+	allCols := false
+	for _, ev := range events {
+		switch ev.ObjectType {
+		case "row":
+			if ev.EventType != "deleted" {
+				rowIDs[ev.ID] = true
+				allCols = true
+			}
+		case "cell":
+			rowIDs[ev.RowID] = true
+			colIDs[ev.ColumnID] = true
+		}
+	}
+
+	var rows map[int64]*Row
+	var err error
+	if len(rowIDs) > 0 {
+		filter := "rowIds=" + joinIDs(rowIDs)
+		if !allCols {
+			filter += "&columnIds=" + joinIDs(colIDs)
+		}
+
+		var s *Sheet
+		if s, err = e.client.GetSheetWithContext(ctx, strconv.FormatInt(sheetID, 10), filter); err != nil {
+			err = errors.Wrapf(err, "Failed to fetch changed rows for sheet %v", sheetID)
+		} else {
+			rows = make(map[int64]*Row, len(s.Rows))
+			for i := range s.Rows {
+				rows[s.Rows[i].ID] = &s.Rows[i]
+			}
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	enriched := make([]EnrichedEvent, 0, len(events))
+	for _, ev := range events {
+		out := EnrichedEvent{WebhookEvent: ev, SheetID: sheetID}
+
+		rowID := ev.RowID
+		if ev.ObjectType == "row" {
+			rowID = ev.ID
+		}
+
+		if rowID != 0 {
+			if old, exists := e.cache.get(sheetID, rowID); exists {
+				out.OldRow = old
+				if ev.ObjectType == "cell" {
+					out.OldCell = cellFor(old, ev.ColumnID)
+				}
+			}
+
+			switch {
+			case ev.ObjectType == "row" && ev.EventType == "deleted":
+				e.cache.remove(sheetID, rowID)
+			case err != nil:
+				out.Err = err
+			default:
+				if r, exists := rows[rowID]; exists {
+					out.Row = r
+					if ev.ObjectType == "cell" {
+						out.Cell = cellFor(r, ev.ColumnID)
+					}
+				}
+			}
+		}
+
+		enriched = append(enriched, out)
+	}
+
+	//cache after building the events so every event in the batch sees the same old values
+	for _, r := range rows {
+		e.cacheRow(sheetID, r)
+	}
+
+	return enriched
+}
+
+// cacheRow stores a copy of the row merging cells into any cached copy
+func (e *Enricher) cacheRow(sheetID int64, r *Row) {
+	cp := *r
+	cp.Cells = append([]Cell(nil), r.Cells...)
+	if old, exists := e.cache.get(sheetID, r.ID); exists {
+		for _, c := range old.Cells {
+			if cellFor(&cp, c.ColumnID) == nil {
+				cp.Cells = append(cp.Cells, c)
+			}
+		}
+	}
+
+	e.cache.put(sheetID, &cp)
+}
+
+type rowCacheKey struct {
+	sheetID int64
+	rowID   int64
+}
+
+type rowCacheEntry struct {
+	key rowCacheKey
+	row *Row
+}
+
+// rowCache is a least recently used cache of rows, it is not safe for concurrent use
+type rowCache struct {
+	max   int
+	order *list.List //most recently used at the front, values are *rowCacheEntry
+	rows  map[rowCacheKey]*list.Element
+}
+
+func newRowCache(size int) *rowCache {
+	return &rowCache{max: size, order: list.New(), rows: make(map[rowCacheKey]*list.Element)}
+}
+
+func (rc *rowCache) get(sheetID, rowID int64) (*Row, bool) {
+	el, exists := rc.rows[rowCacheKey{sheetID, rowID}]
+	if !exists {
+		return nil, false
+	}
+
+	rc.order.MoveToFront(el)
+	return el.Value.(*rowCacheEntry).row, true
+}
+
+func (rc *rowCache) put(sheetID int64, r *Row) {
+	k := rowCacheKey{sheetID, r.ID}
+	if el, exists := rc.rows[k]; exists {
+		el.Value.(*rowCacheEntry).row = r
+		rc.order.MoveToFront(el)
+		return
+	}
+
+	rc.rows[k] = rc.order.PushFront(&rowCacheEntry{key: k, row: r})
+	for rc.order.Len() > rc.max {
+		oldest := rc.order.Back()
+		rc.order.Remove(oldest)
+		delete(rc.rows, oldest.Value.(*rowCacheEntry).key)
+	}
+}
+
+func (rc *rowCache) remove(sheetID, rowID int64) {
+	k := rowCacheKey{sheetID, rowID}
+	if el, exists := rc.rows[k]; exists {
+		rc.order.Remove(el)
+		delete(rc.rows, k)
+	}
+}
+
+func joinIDs(ids map[int64]bool) string {
+	list := make([]string, 0, len(ids))
+	for id := range ids {
+		list = append(list, strconv.FormatInt(id, 10))
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
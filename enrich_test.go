@@ -0,0 +1,110 @@
+package goSmartSheet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnricher(t *testing.T) {
+	assert := assert.New(t)
+
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		io.WriteString(w, `{"id":1,"rows":[{"id":10,"cells":[{"columnId":20,"value":"new"}]}]}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	e := NewEnricher(c, EnricherOptions{BatchWindow: 10 * time.Millisecond})
+
+	var old CellValue
+	old.SetString("old")
+	e.Seed(&Sheet{ID: 1, Rows: []Row{
+		{ID: 10, Cells: []Cell{{ColumnID: 20, Value: &old}}},
+		{ID: 11},
+	}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go e.Run(ctx)
+
+	assert.NoError(e.HandleCallback(&WebhookCallback{ScopeObjectID: 1, Events: []WebhookEvent{
+		{ObjectType: "cell", EventType: "updated", RowID: 10, ColumnID: 20},
+	}}))
+	assert.NoError(e.HandleCallback(&WebhookCallback{ScopeObjectID: 1, Events: []WebhookEvent{
+		{ObjectType: "row", EventType: "deleted", ID: 11},
+		{ObjectType: "sheet", EventType: "updated", ID: 1},
+	}}))
+
+	var got []EnrichedEvent
+	for len(got) < 3 {
+		select {
+		case ev := <-e.Events():
+			got = append(got, ev)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for events")
+		}
+	}
+
+	assert.Equal("rowIds=10&columnIds=20", query)
+
+	assert.NoError(got[0].Err)
+	assert.Equal(int64(1), got[0].SheetID)
+	assert.Equal("new", got[0].Cell.Value.String())
+	assert.Equal("old", got[0].OldCell.Value.String())
+
+	assert.Equal("row.deleted", got[1].Type())
+	assert.Nil(got[1].Row)
+	assert.Equal(int64(11), got[1].OldRow.ID)
+
+	assert.Equal("sheet.updated", got[2].Type())
+	assert.Nil(got[2].Row)
+}
+
+func TestEnricher_RunOnce(t *testing.T) {
+	assert := assert.New(t)
+
+	c, err := GetClient("key", "http://localhost/2.0")
+	assert.NoError(err)
+	e := NewEnricher(c, EnricherOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(context.Canceled, e.Run(ctx))
+	assert.Error(e.Run(ctx), "second Run must not close the channel again")
+
+	_, open := <-e.Events()
+	assert.False(open)
+}
+
+func TestRowCache_Evicts(t *testing.T) {
+	assert := assert.New(t)
+
+	rc := newRowCache(2)
+	rc.put(1, &Row{ID: 10})
+	rc.put(1, &Row{ID: 11})
+	_, exists := rc.get(1, 10) //10 is now more recent than 11
+	assert.True(exists)
+
+	rc.put(2, &Row{ID: 10})
+	_, exists = rc.get(1, 11)
+	assert.False(exists)
+	_, exists = rc.get(1, 10)
+	assert.True(exists)
+	_, exists = rc.get(2, 10)
+	assert.True(exists)
+
+	rc.remove(2, 10)
+	_, exists = rc.get(2, 10)
+	assert.False(exists)
+	assert.Equal(1, rc.order.Len())
+}
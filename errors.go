@@ -0,0 +1,129 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Documented error codes returned within ErrorItem.ErrorCode
+// https://smartsheet-platform.github.io/api-docs/#complete-error-code-list
+const (
+	ErrorCodeAccessTokenRequired = 1001
+	ErrorCodeInvalidToken        = 1002
+	ErrorCodeExpiredToken        = 1003
+	ErrorCodeNotAuthorized       = 1004
+	ErrorCodeNotFound            = 1006
+	ErrorCodeUnsupportedMethod   = 1007
+	ErrorCodeInvalidRequest      = 1008
+	ErrorCodeMissingAttribute    = 1012
+	ErrorCodeInvalidValue        = 1018
+	ErrorCodePicklistViolation   = 1042
+	ErrorCodeUnexpected          = 4000
+	ErrorCodeMaintenance         = 4001
+	ErrorCodeServerTimeout       = 4002
+	ErrorCodeRateLimited         = 4003
+	ErrorCodeVersionConflict     = 4004
+)
+
+// ErrorCode is an error code returned by SmartSheet, it matches any ErrorItem with the same code through errors.Is.
+// Use errors.As with *ErrorItem to get the message and refId of the failure.
+//
+//	if errors.Is(err, ErrNotFound) { ... }
+type ErrorCode int
+
+// Sentinel errors for the documented error codes
+const (
+	ErrAccessTokenRequired ErrorCode = ErrorCodeAccessTokenRequired
+	ErrInvalidToken        ErrorCode = ErrorCodeInvalidToken
+	ErrExpiredToken        ErrorCode = ErrorCodeExpiredToken
+	ErrNotAuthorized       ErrorCode = ErrorCodeNotAuthorized
+	ErrNotFound            ErrorCode = ErrorCodeNotFound
+	ErrUnsupportedMethod   ErrorCode = ErrorCodeUnsupportedMethod
+	ErrInvalidRequest      ErrorCode = ErrorCodeInvalidRequest
+	ErrMissingAttribute    ErrorCode = ErrorCodeMissingAttribute
+	ErrInvalidValue        ErrorCode = ErrorCodeInvalidValue
+	ErrPicklistViolation   ErrorCode = ErrorCodePicklistViolation
+	ErrUnexpected          ErrorCode = ErrorCodeUnexpected
+	ErrMaintenance         ErrorCode = ErrorCodeMaintenance
+	ErrServerTimeout       ErrorCode = ErrorCodeServerTimeout
+	ErrRateLimited         ErrorCode = ErrorCodeRateLimited
+	ErrVersionConflict     ErrorCode = ErrorCodeVersionConflict
+)
+
+// errorCodeMessages are the documented messages of the error codes
+var errorCodeMessages = map[ErrorCode]string{
+	ErrAccessTokenRequired: "An Access Token is required",
+	ErrInvalidToken:        "Invalid access token",
+	ErrExpiredToken:        "Access token has expired",
+	ErrNotAuthorized:       "You are not authorized to perform this action",
+	ErrNotFound:            "Not Found",
+	ErrUnsupportedMethod:   "HTTP Method not supported",
+	ErrInvalidRequest:      "Unable to parse request",
+	ErrMissingAttribute:    "Required object attribute(s) are missing",
+	ErrInvalidValue:        "The value is not valid",
+	ErrPicklistViolation:   "The cell value does not conform to the strict requirements of the column type",
+	ErrUnexpected:          "An unexpected error has occurred",
+	ErrMaintenance:         "SmartSheet is offline for system maintenance",
+	ErrServerTimeout:       "Server timeout exceeded",
+	ErrRateLimited:         "Rate limit exceeded",
+	ErrVersionConflict:     "An unexpected error has occurred, please retry your request",
+}
+
+// Error returns the code and its documented message
+func (c ErrorCode) Error() string {
+	if msg, ok := errorCodeMessages[c]; ok {
+		return fmt.Sprintf("Error Code: %v, Message: %v", int(c), msg)
+	}
+	return fmt.Sprintf("Error Code: %v", int(c))
+}
+
+// clientError is an error raised by the client itself rather than returned by SmartSheet
+type clientError string
+
+func (e clientError) Error() string {
+	return string(e)
+}
+
+// ErrRowNotFound is returned when no row exists for the specified key or ID
+const ErrRowNotFound clientError = "Row not found"
+
+// retryableCodes are the error codes returned when the request was not processed, so it is safe to retry after a backoff
+var retryableCodes = map[int]bool{
+	ErrorCodeMaintenance: true,
+	ErrorCodeRateLimited: true,
+}
+
+// Is matches sentinel errors such as ErrNotFound, and other ErrorItems, by error code
+func (e *ErrorItem) Is(target error) bool {
+	switch t := target.(type) {
+	case ErrorCode:
+		return t != 0 && e.ErrorCode == int(t)
+	case *ErrorItem:
+		return t.ErrorCode != 0 && e.ErrorCode == t.ErrorCode
+	}
+	return false
+}
+
+// Retryable returns true when the request was not processed and can be retried whatever its verb, such as when
+// rate limited.  Other server failures such as 4000 or 503 may have been processed before failing, so they are
+// not reported as retryable.  Only requests without side effects, such as GET requests, should be repeated after them.
+func (e *ErrorItem) Retryable() bool {
+	return retryableCodes[e.ErrorCode] || e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsRetryable returns true when err, or an error it wraps, is an ErrorItem that can be retried
+func IsRetryable(err error) bool {
+	var e *ErrorItem
+	return errors.As(err, &e) && e.Retryable()
+}
+
+// RefID returns the refId of the ErrorItem within err, which SmartSheet support uses to find the failure
+func RefID(err error) string {
+	var e *ErrorItem
+	if errors.As(err, &e) {
+		return e.RefID
+	}
+	return ""
+}
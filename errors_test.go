@@ -0,0 +1,65 @@
+package goSmartSheet
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorItem_Is(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/sheets/1":
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found","refId":"abc123"}`)
+		case "/2.0/sheets/2":
+			w.WriteHeader(http.StatusTooManyRequests)
+			io.WriteString(w, `{"errorCode":4003,"message":"Rate limit exceeded.","refId":"def456"}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"errorCode":1042,"message":"The value for cell in column 1 did not conform to the strict requirements for type PICKLIST."}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	_, err = c.GetSheet("1", "")
+	assert.True(errors.Is(err, ErrNotFound))
+	assert.False(errors.Is(err, ErrRateLimited))
+	assert.False(IsRetryable(err))
+	assert.Equal("abc123", RefID(err))
+
+	var item *ErrorItem
+	assert.True(errors.As(err, &item))
+	assert.Equal(http.StatusNotFound, item.StatusCode)
+
+	_, err = c.GetSheet("2", "")
+	assert.True(errors.Is(err, ErrRateLimited))
+	assert.True(IsRetryable(err))
+	assert.Equal("def456", RefID(err))
+
+	_, err = c.GetSheet("3", "")
+	assert.True(errors.Is(err, ErrPicklistViolation))
+	assert.False(IsRetryable(err))
+
+	assert.True((&ErrorItem{StatusCode: http.StatusTooManyRequests}).Retryable())
+	assert.True((&ErrorItem{ErrorCode: ErrorCodeMaintenance, StatusCode: http.StatusServiceUnavailable}).Retryable())
+
+	//the request may have been processed before these failures
+	assert.False((&ErrorItem{StatusCode: http.StatusServiceUnavailable}).Retryable())
+	assert.False((&ErrorItem{ErrorCode: ErrorCodeUnexpected, StatusCode: http.StatusInternalServerError}).Retryable())
+	assert.False(errors.Is(errors.New("other"), ErrNotFound))
+	assert.True(errors.Is(&ErrorItem{ErrorCode: ErrorCodeNotFound}, &ErrorItem{ErrorCode: ErrorCodeNotFound}))
+	assert.Equal("Error Code: 1006, Message: Not Found", ErrNotFound.Error())
+	assert.Equal("Error Code: 9999", ErrorCode(9999).Error())
+	assert.True(errors.Is(errors.Wrap(ErrRowNotFound, "Key 'a'"), ErrRowNotFound))
+	assert.Equal("", RefID(errors.New("other")))
+}
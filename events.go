@@ -0,0 +1,424 @@
+package goSmartSheet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Event object types reported by the events API
+const (
+	EventObjectAccessToken   = "ACCESS_TOKEN"
+	EventObjectAttachment    = "ATTACHMENT"
+	EventObjectDashboard     = "DASHBOARD"
+	EventObjectDiscussion    = "DISCUSSION"
+	EventObjectFolder        = "FOLDER"
+	EventObjectForm          = "FORM"
+	EventObjectGroup         = "GROUP"
+	EventObjectReport        = "REPORT"
+	EventObjectSheet         = "SHEET"
+	EventObjectUpdateRequest = "UPDATE_REQUEST"
+	EventObjectUser          = "USER"
+	EventObjectWorkspace     = "WORKSPACE"
+)
+
+// Event actions reported by the events API
+const (
+	EventActionCreate            = "CREATE"
+	EventActionUpdate            = "UPDATE"
+	EventActionDelete            = "DELETE"
+	EventActionLoad              = "LOAD"
+	EventActionRename            = "RENAME"
+	EventActionCopy              = "COPY"
+	EventActionMove              = "MOVE"
+	EventActionExport            = "EXPORT"
+	EventActionPurge             = "PURGE"
+	EventActionRestore           = "RESTORE"
+	EventActionAddShare          = "ADD_SHARE"
+	EventActionRemoveShare       = "REMOVE_SHARE"
+	EventActionTransferOwnership = "TRANSFER_OWNERSHIP"
+	EventActionAddMember         = "ADD_MEMBER"
+	EventActionRemoveMember      = "REMOVE_MEMBER"
+	EventActionActivate          = "ACTIVATE"
+	EventActionDeactivate        = "DEACTIVATE"
+	EventActionSendAsAttachment  = "SEND_AS_ATTACHMENT"
+)
+
+// EventObjectID is the ID of the object an Event refers to, which is returned as either a number or a string
+type EventObjectID string
+
+// UnmarshalJSON accepts both numbers and strings, null is decoded as an empty ID
+func (id *EventObjectID) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*id = ""
+		return nil
+	}
+
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return errors.Wrap(err, "Failed to decode object ID")
+		}
+		*id = EventObjectID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return errors.Wrapf(err, "Object ID %s is neither a string nor a number", b)
+	}
+	*id = EventObjectID(n)
+	return nil
+}
+
+// Int64 returns the ID as a number for object types that use numeric IDs
+func (id EventObjectID) Int64() (int64, error) {
+	return strconv.ParseInt(string(id), 10, 64)
+}
+
+// Event is a single organization level audit event
+// https://smartsheet-platform.github.io/api-docs/#event-object
+type Event struct {
+	EventID           string          `json:"eventId"`
+	ObjectType        string          `json:"objectType"`
+	Action            string          `json:"action"`
+	ObjectID          EventObjectID   `json:"objectId"`
+	EventTimestamp    time.Time       `json:"eventTimestamp"`
+	UserID            int64           `json:"userId"`
+	RequestUserID     int64           `json:"requestUserId"`
+	AccessTokenName   string          `json:"accessTokenName,omitempty"`
+	Source            string          `json:"source"`
+	AdditionalDetails json.RawMessage `json:"additionalDetails,omitempty"`
+}
+
+// Type returns the object type and action of the event such as SHEET.CREATE
+func (e *Event) Type() string {
+	return e.ObjectType + "." + e.Action
+}
+
+// Is returns true when the event matches the object type and action
+func (e *Event) Is(objectType, action string) bool {
+	return e.ObjectType == objectType && e.Action == action
+}
+
+// DecodeDetails decodes the additional details, which differ per object type and action, into v
+func (e *Event) DecodeDetails(v interface{}) error {
+	if len(e.AdditionalDetails) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(e.AdditionalDetails, v); err != nil {
+		return errors.Wrapf(err, "Failed to decode details of %v event into %T", e.Type(), v)
+	}
+	return nil
+}
+
+// SheetCreateDetails are the details of SHEET.CREATE events
+type SheetCreateDetails struct {
+	SheetName      string `json:"sheetName"`
+	SourceType     string `json:"sourceType"`
+	SourceObjectID int64  `json:"sourceObjectId"`
+}
+
+// SheetDeleteDetails are the details of SHEET.DELETE and SHEET.PURGE events
+type SheetDeleteDetails struct {
+	SheetName string `json:"sheetName"`
+}
+
+// ExportDetails are the details of SHEET.EXPORT and REPORT.EXPORT events
+type ExportDetails struct {
+	SheetName  string `json:"sheetName"`
+	FormatType string `json:"formatType"`
+}
+
+// RenameDetails are the details of RENAME events
+type RenameDetails struct {
+	OldName string `json:"oldName"`
+	NewName string `json:"newName"`
+}
+
+// ShareDetails are the details of ADD_SHARE and REMOVE_SHARE events, either UserID or GroupID is set
+type ShareDetails struct {
+	UserID      int64  `json:"userId,omitempty"`
+	GroupID     int64  `json:"groupId,omitempty"`
+	AccessLevel string `json:"accessLevel,omitempty"`
+}
+
+// TransferOwnershipDetails are the details of TRANSFER_OWNERSHIP events
+type TransferOwnershipDetails struct {
+	OldOwnerUserID int64 `json:"oldOwnerUserId"`
+	NewOwnerUserID int64 `json:"newOwnerUserId"`
+}
+
+// GroupCreateDetails are the details of GROUP.CREATE events
+type GroupCreateDetails struct {
+	GroupName string `json:"groupName"`
+}
+
+// GroupMemberDetails are the details of GROUP.ADD_MEMBER and GROUP.REMOVE_MEMBER events
+type GroupMemberDetails struct {
+	MemberUserID int64 `json:"memberUserId"`
+}
+
+// eventDetails returns a new details struct for each event type, keyed by Event.Type
+var eventDetails = newEventDetails()
+
+func newEventDetails() map[string]func() interface{} {
+	m := map[string]func() interface{}{
+		EventObjectSheet + "." + EventActionCreate:       func() interface{} { return &SheetCreateDetails{} },
+		EventObjectSheet + "." + EventActionDelete:       func() interface{} { return &SheetDeleteDetails{} },
+		EventObjectSheet + "." + EventActionPurge:        func() interface{} { return &SheetDeleteDetails{} },
+		EventObjectSheet + "." + EventActionExport:       func() interface{} { return &ExportDetails{} },
+		EventObjectReport + "." + EventActionExport:      func() interface{} { return &ExportDetails{} },
+		EventObjectGroup + "." + EventActionCreate:       func() interface{} { return &GroupCreateDetails{} },
+		EventObjectGroup + "." + EventActionAddMember:    func() interface{} { return &GroupMemberDetails{} },
+		EventObjectGroup + "." + EventActionRemoveMember: func() interface{} { return &GroupMemberDetails{} },
+	}
+
+	for _, objectType := range []string{EventObjectDashboard, EventObjectFolder, EventObjectGroup, EventObjectReport, EventObjectSheet, EventObjectWorkspace} {
+		m[objectType+"."+EventActionRename] = func() interface{} { return &RenameDetails{} }
+	}
+
+	for _, objectType := range []string{EventObjectDashboard, EventObjectFolder, EventObjectReport, EventObjectSheet, EventObjectWorkspace} {
+		m[objectType+"."+EventActionAddShare] = func() interface{} { return &ShareDetails{} }
+		m[objectType+"."+EventActionRemoveShare] = func() interface{} { return &ShareDetails{} }
+		m[objectType+"."+EventActionTransferOwnership] = func() interface{} { return &TransferOwnershipDetails{} }
+	}
+
+	return m
+}
+
+// Details decodes the additional details into the struct for the object type and action of the event,
+// such as *RenameDetails for SHEET.RENAME.  Details of other events are decoded into a map[string]interface{},
+// or DecodeDetails can be used with a struct of your own.  nil is returned when the event has no details.
+//
+//	switch d := details.(type) {
+//	case *RenameDetails:
+//		...
+//	}
+func (e *Event) Details() (interface{}, error) {
+	if len(e.AdditionalDetails) == 0 {
+		return nil, nil
+	}
+
+	var v interface{} = &map[string]interface{}{}
+	if newDetails, ok := eventDetails[e.Type()]; ok {
+		v = newDetails()
+	}
+
+	if err := e.DecodeDetails(v); err != nil {
+		return nil, err
+	}
+
+	if m, ok := v.(*map[string]interface{}); ok {
+		return *m, nil
+	}
+	return v, nil
+}
+
+// eventsResponse is a single page of the event stream
+type eventsResponse struct {
+	NextStreamPosition string  `json:"nextStreamPosition"`
+	MoreAvailable      bool    `json:"moreAvailable"`
+	Data               []Event `json:"data"`
+}
+
+// PositionStore persists the position within the event stream so it can be resumed
+type PositionStore interface {
+	// Load returns the saved position, or an empty string when there is none
+	Load() (string, error)
+	// Save persists the position
+	Save(position string) error
+}
+
+// FilePositionStore saves the stream position within a file
+type FilePositionStore struct {
+	Path string
+}
+
+// Load returns the position saved within the file
+func (f *FilePositionStore) Load() (string, error) {
+	b, err := os.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to read stream position from %v", f.Path)
+	}
+
+	return string(bytes.TrimSpace(b)), nil
+}
+
+// Save writes the position to a temporary file and renames it so a crash never leaves a partial position
+func (f *FilePositionStore) Save(position string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "Failed to save stream position")
+	}
+
+	if _, err = tmp.WriteString(position); err == nil {
+		err = tmp.Close()
+	} else {
+		tmp.Close()
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.Path)
+	}
+
+	if err != nil {
+		os.Remove(tmp.Name())
+		return errors.Wrapf(err, "Failed to save stream position to %v", f.Path)
+	}
+
+	return nil
+}
+
+// EventStream iterates over the organization event stream
+//
+//	stream := client.Events(ctx, time.Now().Add(-24*time.Hour), &FilePositionStore{Path: "events.pos"})
+//	for stream.Next() {
+//		e := stream.Event()
+//	}
+//	if err := stream.Err(); err != nil { ... }
+type EventStream struct {
+	// MaxCount is the number of events requested per page, defaults to 1000
+	MaxCount int
+	// PollInterval makes Next wait for new events once the stream is caught up instead of returning false
+	PollInterval time.Duration
+
+	client  *Client
+	ctx     context.Context
+	store   PositionStore
+	since   time.Time
+	pos     string
+	loaded  bool
+	pending bool //pos has not been saved to the store
+	more    bool
+	buf     []Event
+	cur     *Event
+	err     error
+}
+
+// Events returns a stream of the events of the organization.  This requires a system admin token.
+// Every request is made with ctx, so cancelling it also aborts a fetch in progress.
+// The stream starts from the position within the store when there is one, otherwise from since.
+// Positions are saved to the store once every event of a page has been read, so events are delivered at least once.
+// The store may be nil when positions do not need to be saved.
+func (c *Client) Events(ctx context.Context, since time.Time, store PositionStore) *EventStream {
+	return &EventStream{client: c, ctx: ctx, store: store, since: since, more: true}
+}
+
+// Next advances to the next event returning false when the stream is caught up or an error occurred.
+// Next can be called again after returning false to continue once more events are available.
+func (s *EventStream) Next() bool {
+	if s.err != nil {
+		return false
+	}
+
+	for len(s.buf) == 0 {
+		if s.err = s.checkpoint(); s.err != nil {
+			return false
+		}
+
+		if !s.more {
+			if s.PollInterval <= 0 {
+				s.more = true //allow the caller to resume later
+				return false
+			}
+
+			select {
+			case <-s.ctx.Done():
+				s.err = s.ctx.Err()
+				return false
+			case <-time.After(s.PollInterval):
+			}
+		}
+
+		if s.err = s.fetch(); s.err != nil {
+			return false
+		}
+	}
+
+	s.cur = &s.buf[0]
+	s.buf = s.buf[1:]
+	return true
+}
+
+// Event returns the current event
+func (s *EventStream) Event() *Event {
+	return s.cur
+}
+
+// Err returns the error that stopped the stream
+func (s *EventStream) Err() error {
+	return s.err
+}
+
+// Position returns the position after the last fetched page
+func (s *EventStream) Position() string {
+	return s.pos
+}
+
+func (s *EventStream) checkpoint() error {
+	if !s.pending || s.store == nil {
+		return nil
+	}
+
+	if err := s.store.Save(s.pos); err != nil {
+		return err
+	}
+	s.pending = false
+	return nil
+}
+
+func (s *EventStream) fetch() error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+
+	if !s.loaded {
+		if s.store != nil {
+			pos, err := s.store.Load()
+			if err != nil {
+				return err
+			}
+			s.pos = pos
+		}
+		s.loaded = true
+	}
+
+	maxCount := s.MaxCount
+	if maxCount <= 0 {
+		maxCount = 1000
+	}
+
+	q := url.Values{}
+	q.Set("maxCount", strconv.Itoa(maxCount))
+	if s.pos != "" {
+		q.Set("streamPosition", s.pos)
+	} else {
+		q.Set("since", s.since.UTC().Format(time.RFC3339))
+	}
+
+	resp := &eventsResponse{}
+	if err := s.client.getObjectContext(s.ctx, "events?"+q.Encode(), resp); err != nil {
+		return errors.Wrap(err, "Failed to read event stream")
+	}
+
+	s.buf = resp.Data
+	s.more = resp.MoreAvailable
+	if resp.NextStreamPosition != "" && resp.NextStreamPosition != s.pos {
+		s.pos = resp.NextStreamPosition
+		s.pending = true
+	}
+
+	return nil
+}
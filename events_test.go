@@ -0,0 +1,156 @@
+package goSmartSheet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Events(t *testing.T) {
+	assert := assert.New(t)
+
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		queries = append(queries, q.Get("since")+"|"+q.Get("streamPosition"))
+		switch q.Get("streamPosition") {
+		case "":
+			io.WriteString(w, `{"nextStreamPosition":"p1","moreAvailable":true,"data":[
+				{"eventId":"e1","objectType":"SHEET","action":"CREATE","objectId":123,"additionalDetails":{"sheetName":"Tasks"}}]}`)
+		case "p1":
+			io.WriteString(w, `{"nextStreamPosition":"p2","moreAvailable":false,"data":[
+				{"eventId":"e2","objectType":"USER","action":"ADD_SHARE","objectId":"abc"}]}`)
+		default:
+			io.WriteString(w, `{"nextStreamPosition":"p2","moreAvailable":false,"data":[]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	store := &FilePositionStore{Path: filepath.Join(t.TempDir(), "events.pos")}
+	since := time.Date(2017, 5, 22, 0, 0, 0, 0, time.UTC)
+	stream := c.Events(context.Background(), since, store)
+
+	assert.True(stream.Next())
+	e := stream.Event()
+	assert.True(e.Is(EventObjectSheet, EventActionCreate))
+	id, err := e.ObjectID.Int64()
+	assert.NoError(err)
+	assert.Equal(int64(123), id)
+
+	var details struct {
+		SheetName string `json:"sheetName"`
+	}
+	assert.NoError(e.DecodeDetails(&details))
+	assert.Equal("Tasks", details.SheetName)
+
+	//the position is only saved once the page has been read
+	pos, _ := store.Load()
+	assert.Equal("", pos)
+
+	assert.True(stream.Next())
+	assert.Equal("USER.ADD_SHARE", stream.Event().Type())
+	assert.Equal(EventObjectID("abc"), stream.Event().ObjectID)
+	pos, _ = store.Load()
+	assert.Equal("p1", pos)
+
+	assert.False(stream.Next())
+	assert.NoError(stream.Err())
+	pos, _ = store.Load()
+	assert.Equal("p2", pos)
+
+	//a new stream resumes from the saved position
+	stream = c.Events(context.Background(), since, store)
+	assert.False(stream.Next())
+	assert.NoError(stream.Err())
+
+	assert.Equal([]string{"2017-05-22T00:00:00Z|", "|p1", "|p2"}, queries)
+}
+
+func TestClient_EventsCancelFetch(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done() //never answers, the client must abort the request
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	stream := c.Events(ctx, time.Now(), nil)
+	assert.False(stream.Next())
+	assert.True(errors.Is(stream.Err(), context.DeadlineExceeded))
+	assert.True(time.Since(start) < time.Second)
+}
+
+func TestEvent_Details(t *testing.T) {
+	assert := assert.New(t)
+
+	var events []Event
+	assert.NoError(json.Unmarshal([]byte(`[
+		{"objectType":"SHEET","action":"CREATE","additionalDetails":{"sheetName":"Tasks","sourceType":"sheet","sourceObjectId":5}},
+		{"objectType":"FOLDER","action":"RENAME","additionalDetails":{"oldName":"a","newName":"b"}},
+		{"objectType":"WORKSPACE","action":"ADD_SHARE","additionalDetails":{"groupId":9,"accessLevel":"EDITOR"}},
+		{"objectType":"GROUP","action":"ADD_MEMBER","additionalDetails":{"memberUserId":3}},
+		{"objectType":"USER","action":"UPDATE","additionalDetails":{"admin":true}},
+		{"objectType":"SHEET","action":"LOAD"},
+		{"objectType":"SHEET","action":"RENAME","additionalDetails":{"oldName":1}}]`), &events))
+
+	d, err := events[0].Details()
+	assert.NoError(err)
+	assert.Equal(&SheetCreateDetails{SheetName: "Tasks", SourceType: "sheet", SourceObjectID: 5}, d)
+
+	d, err = events[1].Details()
+	assert.NoError(err)
+	assert.Equal(&RenameDetails{OldName: "a", NewName: "b"}, d)
+
+	d, err = events[2].Details()
+	assert.NoError(err)
+	assert.Equal(&ShareDetails{GroupID: 9, AccessLevel: "EDITOR"}, d)
+
+	d, err = events[3].Details()
+	assert.NoError(err)
+	assert.Equal(&GroupMemberDetails{MemberUserID: 3}, d)
+
+	//events without a struct are decoded into a map
+	d, err = events[4].Details()
+	assert.NoError(err)
+	assert.Equal(map[string]interface{}{"admin": true}, d)
+
+	d, err = events[5].Details()
+	assert.NoError(err)
+	assert.Nil(d)
+
+	_, err = events[6].Details()
+	assert.Error(err)
+	assert.Contains(err.Error(), "SHEET.RENAME")
+}
+
+func TestEventObjectID_UnmarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	var e struct {
+		IDs []EventObjectID `json:"ids"`
+	}
+	assert.NoError(json.Unmarshal([]byte(`{"ids":[123,"abc","a\"bc",null]}`), &e))
+	assert.Equal([]EventObjectID{"123", "abc", `a"bc`, ""}, e.IDs)
+
+	var id EventObjectID
+	assert.Error(json.Unmarshal([]byte(`true`), &id))
+	assert.Error(json.Unmarshal([]byte(`{"id":1}`), &id))
+}
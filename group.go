@@ -0,0 +1,111 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Group is a named collection of users within the organization
+// https://smartsheet-platform.github.io/api-docs/#group-object
+type Group struct {
+	ID          int64         `json:"id,omitempty"`
+	Name        string        `json:"name,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Owner       string        `json:"owner,omitempty"`
+	OwnerID     int64         `json:"ownerId,omitempty"`
+	Members     []GroupMember `json:"members,omitempty"`
+	CreatedAt   *time.Time    `json:"createdAt,omitempty"`
+	ModifiedAt  *time.Time    `json:"modifiedAt,omitempty"`
+}
+
+// GroupMember is a user within a Group, only the email is required when adding members
+// https://smartsheet-platform.github.io/api-docs/#groupmember-object
+type GroupMember struct {
+	ID        int64  `json:"id,omitempty"`
+	Email     string `json:"email"`
+	Name      string `json:"name,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+}
+
+// ListGroups returns every group within the organization, members are not populated
+func (c *Client) ListGroups() ([]Group, error) {
+	return getAllPages[Group](c, "groups")
+}
+
+// GetGroup returns the group including its members
+func (c *Client) GetGroup(id int64) (*Group, error) {
+	g := &Group{}
+	if err := c.getObject(fmt.Sprintf("groups/%v", id), g); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get group (ID: %v)", id)
+	}
+
+	return g, nil
+}
+
+// CreateGroup creates the group along with any members
+func (c *Client) CreateGroup(g Group) (*Group, error) {
+	created := &Group{}
+	body, err := c.postObjectInto(created, "groups", g)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create group %v", g.Name)
+	}
+
+	if err = decodeAsResultResponseInto(body, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateGroup updates the name, description or owner of the group.  Members are changed through
+// AddGroupMembers and RemoveGroupMember.
+func (c *Client) UpdateGroup(g Group) (*Group, error) {
+	if g.ID == 0 {
+		return nil, errors.New("Group ID must be provided")
+	}
+
+	u := Group{Name: g.Name, Description: g.Description, OwnerID: g.OwnerID}
+	updated := &Group{}
+	body, err := c.putObjectInto(updated, fmt.Sprintf("groups/%v", g.ID), u)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to update group (ID: %v)", g.ID)
+	}
+
+	if err = decodeAsResultResponseInto(body, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteGroup removes the group
+func (c *Client) DeleteGroup(id int64) error {
+	return c.deleteObject(fmt.Sprintf("groups/%v", id))
+}
+
+// AddGroupMembers adds the members to the group returning the added members
+func (c *Client) AddGroupMembers(groupID int64, members []GroupMember) ([]GroupMember, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	var added []GroupMember
+	body, err := c.postObjectInto(&added, fmt.Sprintf("groups/%v/members", groupID), members)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to add members to group (ID: %v)", groupID)
+	}
+
+	if err = decodeAsResultResponseInto(body, &added); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// RemoveGroupMember removes the user from the group
+func (c *Client) RemoveGroupMember(groupID, userID int64) error {
+	return c.deleteObject(fmt.Sprintf("groups/%v/members/%v", groupID, userID))
+}
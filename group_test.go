@@ -0,0 +1,48 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Groups(t *testing.T) {
+	assert := assert.New(t)
+
+	var members []GroupMember
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/2.0/groups/4":
+			io.WriteString(w, `{"id":4,"name":"Team","members":[{"id":1,"email":"a@b.com"}]}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/groups/4/members":
+			json.NewDecoder(r.Body).Decode(&members)
+			io.WriteString(w, `{"resultCode":0,"result":[{"id":2,"email":"c@d.com","name":"C"}]}`)
+		case r.Method == "DELETE" && r.URL.Path == "/2.0/groups/4/members/1":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	g, err := c.GetGroup(4)
+	assert.NoError(err)
+	assert.Equal("a@b.com", g.Members[0].Email)
+
+	added, err := c.AddGroupMembers(4, []GroupMember{{Email: "c@d.com"}})
+	assert.NoError(err)
+	assert.Equal(int64(2), added[0].ID)
+	assert.Equal("c@d.com", members[0].Email)
+
+	assert.NoError(c.RemoveGroupMember(4, 1))
+	_, err = c.GetGroup(5)
+	assert.Error(err)
+}
@@ -0,0 +1,285 @@
+package goSmartSheet
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// SheetIndex is an opt-in set of hash indexes over a loaded Sheet for repeated lookups on large sheets.
+// Every column is indexed by both its normalized display and raw values, rows are also indexed by ID and row number.
+//
+// An index created through Client.IndexSheet is marked stale whenever rows or columns of the sheet are successfully
+// changed through that client, and the next lookup reloads the sheet before answering.  An error is returned by the
+// lookup when the sheet cannot be reloaded, the index is never answered from a stale sheet.
+//
+// Rows returned by lookups point into the Sheet the index was built from, which is replaced when the sheet is reloaded.
+type SheetIndex struct {
+	mu       sync.RWMutex
+	sheet    *Sheet
+	client   *Client
+	filter   string //query the sheet was loaded with, reused when it is reloaded
+	stale    bool
+	gen      uint64 //incremented by every invalidation
+	colIDs   map[string]int64
+	values   map[int64]map[string][]int //column ID -> normalized value -> row positions
+	byID     map[int64]int
+	byNumber map[int]int
+}
+
+// NewSheetIndex builds an index over the rows of the sheet.  It is not updated when the client changes the sheet.
+func NewSheetIndex(s *Sheet) *SheetIndex {
+	idx := &SheetIndex{}
+	idx.build(s)
+	return idx
+}
+
+// IndexSheet builds an index over the rows of the sheet which is reloaded on the next lookup after the sheet is changed
+// through the client.  filter is the query the sheet was loaded with, such as columnIds=1,2, so the same columns and
+// rows are loaded again.  Call Release when the index is no longer needed.
+func (c *Client) IndexSheet(s *Sheet, filter string) *SheetIndex {
+	idx := &SheetIndex{client: c, filter: filter}
+	idx.build(s)
+
+	if c.indexes == nil {
+		c.indexes = &indexRegistry{}
+	}
+	c.indexes.add(s.IDToA(), idx)
+	return idx
+}
+
+// normalizeKey is used for all values stored within and looked up from an index
+func normalizeKey(v string) string {
+	return strings.ToLower(strings.TrimSpace(v))
+}
+
+func (idx *SheetIndex) build(s *Sheet) {
+	idx.sheet = s
+	idx.colIDs = make(map[string]int64, len(s.Columns))
+	idx.values = make(map[int64]map[string][]int, len(s.Columns))
+	idx.byID = make(map[int64]int, len(s.Rows))
+	idx.byNumber = make(map[int]int, len(s.Rows))
+
+	for _, col := range s.Columns {
+		idx.colIDs[col.Title] = col.ID
+		idx.values[col.ID] = make(map[string][]int)
+	}
+
+	for i := range s.Rows {
+		r := &s.Rows[i]
+		idx.byID[r.ID] = i
+		idx.byNumber[r.RowNumber] = i
+
+		for _, c := range r.Cells {
+			m, exists := idx.values[c.ColumnID]
+			if !exists {
+				m = make(map[string][]int)
+				idx.values[c.ColumnID] = m
+			}
+
+			display := normalizeKey(c.DisplayValue)
+			raw := normalizeKey(c.Value.normalized())
+			if display != "" {
+				m[display] = append(m[display], i)
+			}
+			if raw != "" && raw != display {
+				m[raw] = append(m[raw], i)
+			}
+		}
+	}
+}
+
+// Sheet returns the sheet the index was built from
+func (idx *SheetIndex) Sheet() *Sheet {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.sheet
+}
+
+// Stale returns true when the sheet has been changed through the client since the index was built
+func (idx *SheetIndex) Stale() bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.stale
+}
+
+func (idx *SheetIndex) invalidate() {
+	idx.mu.Lock()
+	idx.stale = true
+	idx.gen++
+	idx.mu.Unlock()
+}
+
+// Rebuild indexes the loaded Sheet again, useful after the rows of the Sheet have been changed locally
+func (idx *SheetIndex) Rebuild() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.build(idx.sheet)
+}
+
+// Refresh reloads the sheet through the client and rebuilds the index.  The index remains stale when the sheet
+// is changed through the client while it is being reloaded.
+func (idx *SheetIndex) Refresh() error {
+	if idx.client == nil {
+		return errors.New("Index was not created through a Client")
+	}
+
+	idx.mu.RLock()
+	sheetID, gen := idx.sheet.IDToA(), idx.gen
+	idx.mu.RUnlock()
+
+	s, err := idx.client.GetSheet(sheetID, idx.filter)
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.build(s)
+	idx.stale = idx.gen != gen
+	return nil
+}
+
+// Release stops the client from tracking changes for this index
+func (idx *SheetIndex) Release() {
+	if idx.client != nil {
+		idx.client.indexes.remove(idx.Sheet().IDToA(), idx)
+	}
+}
+
+// fresh reloads the sheet when the index is stale
+func (idx *SheetIndex) fresh() error {
+	if idx.client == nil || !idx.Stale() {
+		return nil
+	}
+
+	return errors.Wrap(idx.Refresh(), "Failed to reload stale index")
+}
+
+// Lookup returns the rows where the cell within the column matches the value, ignoring case and surrounding whitespace.
+// Both the display value and raw value of the cell are matched.
+func (idx *SheetIndex) Lookup(column string, value string) ([]*Row, error) {
+	if err := idx.fresh(); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	id, exists := idx.colIDs[column]
+	if !exists {
+		return nil, errors.Errorf("Column '%v' does not exist in sheet", column)
+	}
+
+	return idx.rows(idx.values[id][normalizeKey(value)]), nil
+}
+
+// LookupColumnID is the same as Lookup but uses the ID of the column
+func (idx *SheetIndex) LookupColumnID(columnID int64, value string) ([]*Row, error) {
+	if err := idx.fresh(); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.rows(idx.values[columnID][normalizeKey(value)]), nil
+}
+
+// Contains returns true when any cell within the column matches the value, false is returned when the lookup fails
+func (idx *SheetIndex) Contains(column string, value string) bool {
+	rows, err := idx.Lookup(column, value)
+	return err == nil && len(rows) > 0
+}
+
+// RowByID returns the row with the specified ID, ErrRowNotFound is returned when there is no such row
+func (idx *SheetIndex) RowByID(id int64) (*Row, error) {
+	if err := idx.fresh(); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	i, exists := idx.byID[id]
+	if !exists {
+		return nil, ErrRowNotFound
+	}
+	return &idx.sheet.Rows[i], nil
+}
+
+// RowByNumber returns the row with the specified row number, ErrRowNotFound is returned when there is no such row
+func (idx *SheetIndex) RowByNumber(n int) (*Row, error) {
+	if err := idx.fresh(); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	i, exists := idx.byNumber[n]
+	if !exists {
+		return nil, ErrRowNotFound
+	}
+	return &idx.sheet.Rows[i], nil
+}
+
+func (idx *SheetIndex) rows(positions []int) []*Row {
+	rows := make([]*Row, 0, len(positions))
+	for _, i := range positions {
+		rows = append(rows, &idx.sheet.Rows[i])
+	}
+	return rows
+}
+
+// indexRegistry tracks the indexes created through a client by sheet ID
+type indexRegistry struct {
+	mu      sync.Mutex
+	indexes map[string][]*SheetIndex
+}
+
+func (reg *indexRegistry) add(sheetID string, idx *SheetIndex) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if reg.indexes == nil {
+		reg.indexes = make(map[string][]*SheetIndex)
+	}
+	reg.indexes[sheetID] = append(reg.indexes[sheetID], idx)
+}
+
+func (reg *indexRegistry) remove(sheetID string, idx *SheetIndex) {
+	if reg == nil {
+		return
+	}
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	list := reg.indexes[sheetID]
+	for i := range list {
+		if list[i] == idx {
+			reg.indexes[sheetID] = append(list[:i], list[i+1:]...)
+			break
+		}
+	}
+
+	if len(reg.indexes[sheetID]) == 0 {
+		delete(reg.indexes, sheetID)
+	}
+}
+
+// invalidate marks every index for the sheet as stale
+func (reg *indexRegistry) invalidate(sheetID string) {
+	if reg == nil {
+		return
+	}
+
+	reg.mu.Lock()
+	list := append([]*SheetIndex(nil), reg.indexes[sheetID]...)
+	reg.mu.Unlock()
+
+	for _, idx := range list {
+		idx.invalidate()
+	}
+}
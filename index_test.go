@@ -0,0 +1,136 @@
+package goSmartSheet
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSheetIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	s := querySheet()
+	s.Rows[1].RowNumber = 2
+	idx := NewSheetIndex(s)
+
+	rows, err := idx.Lookup("Status", " done ")
+	assert.NoError(err)
+	assert.Len(rows, 3)
+	assert.Same(&s.Rows[0], rows[0])
+
+	rows, err = idx.LookupColumnID(3, "10")
+	assert.NoError(err)
+	assert.Len(rows, 1)
+	assert.Equal(int64(12), rows[0].ID)
+
+	assert.True(idx.Contains("Due", "2017-06-01"))
+	assert.False(idx.Contains("Due", "2017-06-02"))
+
+	_, err = idx.Lookup("Missing", "x")
+	assert.Error(err)
+
+	r, err := idx.RowByID(13)
+	assert.NoError(err)
+	assert.Same(&s.Rows[3], r)
+
+	r, err = idx.RowByNumber(2)
+	assert.NoError(err)
+	assert.Equal(int64(11), r.ID)
+
+	_, err = idx.RowByID(99)
+	assert.Equal(ErrRowNotFound, err)
+}
+
+func TestClient_IndexSheetInvalidation(t *testing.T) {
+	assert := assert.New(t)
+
+	fail := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case fail:
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, `{"errorCode":4000,"message":"An unexpected error has occurred"}`)
+		case r.Method == "GET":
+			io.WriteString(w, `{"id":1,"columns":[{"id":1,"title":"Status"}],"rows":[{"id":10,"cells":[{"columnId":1,"value":"New"}]}]}`)
+		default:
+			io.WriteString(w, `{"resultCode":0,"result":[]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	s := querySheet()
+	s.ID = 1
+	idx := c.IndexSheet(s, "")
+	other := c.IndexSheet(&Sheet{ID: 2}, "")
+	assert.False(idx.Stale())
+
+	_, err = c.UpdateRowsOnSheet("1", []Row{{ID: 10}})
+	assert.NoError(err)
+	assert.True(idx.Stale())
+	assert.False(other.Stale())
+
+	//the next lookup reloads the stale index
+	rows, err := idx.Lookup("Status", "new")
+	assert.NoError(err)
+	assert.Len(rows, 1)
+	assert.False(idx.Stale())
+
+	//failed changes leave the index alone
+	fail = true
+	_, err = c.UpdateRowsOnSheet("1", []Row{{ID: 10}})
+	assert.Error(err)
+	assert.Error(c.DeleteColumn("1", 1))
+	assert.False(idx.Stale())
+
+	//a stale index which cannot be reloaded returns an error rather than old rows
+	idx.invalidate()
+	_, err = idx.Lookup("Status", "new")
+	assert.Error(err)
+	_, err = idx.RowByID(10)
+	assert.Error(err)
+	fail = false
+	assert.NoError(idx.Refresh())
+
+	idx.Release()
+	_, _, err = c.DeleteRowsIdsFromSheet("1", []string{"10"})
+	assert.NoError(err)
+	assert.False(idx.Stale())
+}
+
+func TestClient_IndexSheetRefresh(t *testing.T) {
+	assert := assert.New(t)
+
+	var idx *SheetIndex
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if len(queries) == 1 {
+			//the sheet is changed while the first reload is in flight
+			idx.invalidate()
+		}
+		io.WriteString(w, `{"id":1,"columns":[{"id":1,"title":"Status"}],"rows":[{"id":10,"cells":[{"columnId":1,"value":"New"}]}]}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	s := querySheet()
+	s.ID = 1
+	idx = c.IndexSheet(s, "columnIds=1")
+	defer idx.Release()
+
+	assert.NoError(idx.Refresh())
+	assert.True(idx.Stale())
+
+	assert.NoError(idx.Refresh())
+	assert.False(idx.Stale())
+
+	assert.Equal([]string{"columnIds=1", "columnIds=1"}, queries)
+}
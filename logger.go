@@ -0,0 +1,275 @@
+package goSmartSheet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Logger receives structured logs from the client, args are alternating keys and values.
+// *slog.Logger satisfies this interface.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// LogLevel is the level a message is logged at
+type LogLevel int
+
+const (
+	// LogOff disables the message
+	LogOff LogLevel = iota
+	LogDebug
+	LogInfo
+	LogWarn
+	LogError
+)
+
+// LogOptions controls what the client logs
+type LogOptions struct {
+	// RequestBodies and ResponseBodies are the levels the JSON bodies are logged at, both default to LogOff.
+	// Streamed bodies such as attachment uploads are never logged.  Response bodies are logged once the caller
+	// has read them, and only when they are JSON no larger than MaxBodySize, so they are never buffered.
+	RequestBodies  LogLevel
+	ResponseBodies LogLevel
+	// RedactCellValues replaces cell values and formulas within logged bodies
+	RedactCellValues bool
+	// MaxBodySize limits the number of bytes of a body that are logged, defaults to 4096
+	MaxBodySize int
+}
+
+const defaultMaxLoggedBody = 4096
+
+const redacted = "[REDACTED]"
+
+// tokenFields are always redacted from logged bodies
+var tokenFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"sharedSecret":  true,
+}
+
+// cellFields are redacted from logged bodies when LogOptions.RedactCellValues is set
+var cellFields = map[string]bool{
+	"value":        true,
+	"displayValue": true,
+	"objectValue":  true,
+	"formula":      true,
+	"hyperlink":    true,
+}
+
+// logger returns the Logger and options of the client, VerboseMode logs every request and request body
+// through the log package.  nil is returned when logging is disabled.
+func (c *Client) logger() (Logger, LogOptions) {
+	if c.Logger != nil {
+		return c.Logger, c.LogOptions
+	}
+
+	if c.VerboseMode {
+		opt := c.LogOptions
+		if opt.RequestBodies == LogOff {
+			opt.RequestBodies = LogDebug
+		}
+		return stdLogger{}, opt
+	}
+
+	return nil, LogOptions{}
+}
+
+// requestLog records a request so it can be logged once the response is available
+type requestLog struct {
+	l     Logger
+	opt   LogOptions
+	start time.Time
+	args  []interface{}
+}
+
+// Logging returns a middleware which logs every request attempt.  The client adds it automatically,
+// inside any middleware added through Use, when Logger or VerboseMode is set.
+func Logging(l Logger, opt LogOptions) Middleware {
+	return func(next Handler) Handler {
+		return func(req *Request) (*RequestResult, error) {
+			rl := startRequestLog(l, opt, req)
+
+			resp, err := next(req)
+			if resp == nil {
+				rl.finish(0, nil, nil, err)
+				return nil, err
+			}
+
+			resp.Body = rl.finish(resp.StatusCode, resp.Header, resp.Body, err)
+			return resp, err
+		}
+	}
+}
+
+// startRequestLog logs the request body when enabled
+func startRequestLog(l Logger, opt LogOptions, req *Request) *requestLog {
+	rl := &requestLog{l: l, opt: opt, start: time.Now()}
+	rl.args = []interface{}{"method", req.Verb, "path", req.HTTP.URL.RequestURI(), "attempt", req.Attempt}
+	if id := req.HTTP.Header.Get("X-Request-Id"); id != "" {
+		rl.args = append(rl.args, "requestId", id)
+	}
+
+	//only replayable JSON bodies are logged, reading a stream would consume it
+	if opt.RequestBodies != LogOff && req.HTTP.GetBody != nil && strings.HasPrefix(req.HTTP.Header.Get("Content-Type"), "application/json") {
+		if body, err := req.HTTP.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			body.Close()
+			logAt(l, opt.RequestBodies, "smartsheet request body", append(rl.fields(), "body", rl.redact(b))...)
+		}
+	}
+
+	return rl
+}
+
+// finish logs the outcome of the request, returning the body wrapped so that it is logged once read
+func (rl *requestLog) finish(statusCode int, header http.Header, body io.ReadCloser, err error) io.ReadCloser {
+	args := append(rl.fields(), "status", statusCode, "duration", time.Since(rl.start))
+	switch {
+	case err != nil && statusCode == 0:
+		rl.l.Error("smartsheet request failed", append(args, "error", err.Error())...)
+	case err != nil:
+		if ref := RefID(err); ref != "" {
+			args = append(args, "refId", ref)
+		}
+		rl.l.Warn("smartsheet request unsuccessful", append(args, "error", err.Error())...)
+	default:
+		rl.l.Debug("smartsheet request", args...)
+	}
+
+	if err != nil || body == nil || rl.opt.ResponseBodies == LogOff {
+		return body
+	}
+
+	//exports and attachments are never logged, nor are bodies known to be too large
+	if !strings.HasPrefix(header.Get("Content-Type"), "application/json") {
+		return body
+	}
+	if n, perr := strconv.ParseInt(header.Get("Content-Length"), 10, 64); perr == nil && n > int64(rl.limit()) {
+		return body
+	}
+
+	return &loggedBody{ReadCloser: body, rl: rl}
+}
+
+// loggedBody keeps a copy of the body as the caller reads it, which is logged at the end of the body.
+// Once the body is larger than the limit the copy is dropped and nothing is logged.
+type loggedBody struct {
+	io.ReadCloser
+	rl   *requestLog
+	buf  bytes.Buffer
+	skip bool
+}
+
+func (b *loggedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	if !b.skip {
+		if b.buf.Len()+n > b.rl.limit() {
+			b.skip = true
+			b.buf = bytes.Buffer{}
+		} else {
+			b.buf.Write(p[:n])
+		}
+	}
+
+	if err == io.EOF && !b.skip {
+		b.skip = true
+		logAt(b.rl.l, b.rl.opt.ResponseBodies, "smartsheet response body", append(b.rl.fields(), "body", b.rl.redact(b.buf.Bytes()))...)
+	}
+
+	return n, err
+}
+
+// Close reads the rest of a body which may still be logged, as decoders often stop before the end
+func (b *loggedBody) Close() error {
+	if !b.skip {
+		io.Copy(io.Discard, io.LimitReader(b, int64(b.rl.limit())+1))
+	}
+	return b.ReadCloser.Close()
+}
+
+func (rl *requestLog) fields() []interface{} {
+	return append([]interface{}(nil), rl.args...)
+}
+
+// redact removes tokens, and optionally cell values, from a JSON body and truncates it
+func (rl *requestLog) redact(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err == nil {
+		redactValue(v, rl.opt.RedactCellValues)
+		if out, err := json.Marshal(v); err == nil {
+			b = out
+		}
+	}
+
+	if limit := rl.limit(); len(b) > limit {
+		return string(b[:limit]) + "..."
+	}
+	return string(b)
+}
+
+func (rl *requestLog) limit() int {
+	if rl.opt.MaxBodySize <= 0 {
+		return defaultMaxLoggedBody
+	}
+	return rl.opt.MaxBodySize
+}
+
+func redactValue(v interface{}, cells bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if tokenFields[k] || (cells && cellFields[k]) {
+				t[k] = redacted
+				continue
+			}
+			redactValue(child, cells)
+		}
+	case []interface{}:
+		for _, child := range t {
+			redactValue(child, cells)
+		}
+	}
+}
+
+func logAt(l Logger, level LogLevel, msg string, args ...interface{}) {
+	switch level {
+	case LogDebug:
+		l.Debug(msg, args...)
+	case LogInfo:
+		l.Info(msg, args...)
+	case LogWarn:
+		l.Warn(msg, args...)
+	case LogError:
+		l.Error(msg, args...)
+	}
+}
+
+// stdLogger writes to the log package, it is used by VerboseMode
+type stdLogger struct{}
+
+func (stdLogger) Debug(msg string, args ...interface{}) { stdLog("DEBUG", msg, args) }
+func (stdLogger) Info(msg string, args ...interface{})  { stdLog("INFO", msg, args) }
+func (stdLogger) Warn(msg string, args ...interface{})  { stdLog("WARN", msg, args) }
+func (stdLogger) Error(msg string, args ...interface{}) { stdLog("ERROR", msg, args) }
+
+func stdLog(level, msg string, args []interface{}) {
+	var b strings.Builder
+	b.WriteString(level)
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i+1 < len(args); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+	}
+	log.Print(b.String())
+}
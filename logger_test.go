@@ -0,0 +1,128 @@
+package goSmartSheet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *testLogger) log(level, msg string, args []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	line := level + " " + msg
+	for i := 0; i+1 < len(args); i += 2 {
+		line += fmt.Sprintf(" %v=%v", args[i], args[i+1])
+	}
+	l.lines = append(l.lines, line)
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("DEBUG", msg, args) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("INFO", msg, args) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("WARN", msg, args) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("ERROR", msg, args) }
+
+func TestClient_Logger(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		switch r.Method {
+		case "PUT":
+			io.WriteString(w, `{"resultCode":0,"result":[{"id":1,"cells":[{"columnId":2,"value":"secret","displayValue":"secret"}]}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found","refId":"ref1"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	l := &testLogger{}
+	c.Logger = l
+	c.LogOptions = LogOptions{RequestBodies: LogDebug, ResponseBodies: LogInfo, RedactCellValues: true}
+
+	str := "secret"
+	v := CellValue{StringVal: &str}
+	err = c.WithOptions(WithRequestID("req-9")).updateRows("1", []Row{{ID: 1, Cells: []Cell{{ColumnID: 2, Value: &v}}}})
+	assert.NoError(err)
+
+	_, err = c.GetSheet("2", "")
+	assert.Error(err)
+
+	all := strings.Join(l.lines, "\n")
+	assert.NotContains(all, "secret")
+	assert.NotContains(all, "Bearer")
+	assert.Len(l.lines, 4)
+
+	assert.True(strings.HasPrefix(l.lines[0], "DEBUG smartsheet request body method=PUT path=/2.0/sheets/1/rows attempt=1 requestId=req-9 body="))
+	assert.Contains(l.lines[0], `"value":"[REDACTED]"`)
+	assert.True(strings.HasPrefix(l.lines[1], "DEBUG smartsheet request method=PUT path=/2.0/sheets/1/rows attempt=1 requestId=req-9 status=200 duration="))
+	assert.True(strings.HasPrefix(l.lines[2], "INFO smartsheet response body method=PUT"))
+	assert.Contains(l.lines[2], `"displayValue":"[REDACTED]"`)
+	assert.True(strings.HasPrefix(l.lines[3], "WARN smartsheet request unsuccessful method=GET path=/2.0/sheets/2 attempt=1 status=404 duration="))
+	assert.Contains(l.lines[3], "refId=ref1")
+}
+
+func TestClient_LoggerSkipsResponseBodies(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/sheets/1":
+			w.Header().Set("Content-Type", "text/csv")
+			io.WriteString(w, "Name\nsecret\n")
+		case "/2.0/sheets/2":
+			//too large, but without a Content-Length the body is only dropped while it is read
+			w.Header().Set("Content-Type", "application/json")
+			w.(http.Flusher).Flush()
+			io.WriteString(w, `{"id":2,"name":"`+strings.Repeat("x", 100)+`"}`)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"id":3,"name":"small"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	l := &testLogger{}
+	c.Logger = l
+	c.LogOptions = LogOptions{ResponseBodies: LogInfo, MaxBodySize: 50}
+
+	b, _, err := c.GetWithContext(context.Background(), "sheets/1")
+	assert.NoError(err)
+	all, _ := io.ReadAll(b)
+	b.Close()
+	assert.Equal("Name\nsecret\n", string(all))
+
+	s, err := c.GetSheet("2", "")
+	assert.NoError(err)
+	assert.Len(s.Name, 100)
+
+	_, err = c.GetSheet("3", "")
+	assert.NoError(err)
+
+	var bodies []string
+	for _, line := range l.lines {
+		if strings.HasPrefix(line, "INFO smartsheet response body") {
+			bodies = append(bodies, line)
+		}
+	}
+	assert.Len(bodies, 1)
+	assert.Contains(bodies[0], `body={"id":3,"name":"small"}`)
+}
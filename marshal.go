@@ -0,0 +1,273 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// dateLayout is the layout SmartSheet uses for DATE columns
+const dateLayout = "2006-01-02"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// rowField describes a single struct field that is mapped onto a sheet column via the `ss` tag.
+//
+//	type Task struct {
+//		RowID  int64     `ss:",rowid"`
+//		Name   string    `ss:"Task Name"`
+//		Due    time.Time `ss:"Due Date"`
+//		Ignore string    `ss:"-"`
+//	}
+//
+// Fields without a tag are mapped to a column with the same title as the field name.
+type rowField struct {
+	index []int
+	title string
+	rowID bool
+}
+
+// rowFields returns the mapped fields for the specified struct type
+func rowFields(t reflect.Type) ([]rowField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, errors.Errorf("Type %v must be a struct", t)
+	}
+
+	var fields []rowField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue //unexported
+		}
+
+		tag := f.Tag.Get("ss")
+		if tag == "-" {
+			continue
+		}
+
+		title, opt, _ := strings.Cut(tag, ",")
+		if opt == "rowid" {
+			if f.Type.Kind() != reflect.Int64 {
+				return nil, errors.Errorf("Row ID field %v must be an int64", f.Name)
+			}
+			fields = append(fields, rowField{index: f.Index, rowID: true})
+			continue
+		}
+
+		if title == "" {
+			title = f.Name
+		}
+		fields = append(fields, rowField{index: f.Index, title: title})
+	}
+
+	return fields, nil
+}
+
+// UnmarshalRow will populate the struct pointed to by v from the cells in the row.
+// Columns are matched to fields using the `ss` struct tag and the titles in cols.
+func UnmarshalRow(r *Row, cols []Column, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Errorf("Cannot unmarshal row into non-pointer %T", v)
+	}
+	rv = rv.Elem()
+
+	fields, err := rowFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	titles := make(map[int64]string, len(cols))
+	for _, col := range cols {
+		titles[col.ID] = col.Title
+	}
+
+	cells := make(map[string]*Cell, len(r.Cells))
+	for i := range r.Cells {
+		cells[titles[r.Cells[i].ColumnID]] = &r.Cells[i]
+	}
+
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.rowID {
+			fv.SetInt(r.ID)
+			continue
+		}
+
+		c, exists := cells[f.title]
+		if !exists || c.Value == nil {
+			fv.Set(reflect.Zero(fv.Type()))
+			continue
+		}
+
+		if err := setField(fv, c.Value); err != nil {
+			return errors.Wrapf(err, "Failed to set field for column '%v'", f.title)
+		}
+	}
+
+	return nil
+}
+
+// MarshalRow will build a row from the struct v using the `ss` struct tag to find the matching column in cols.
+// The row ID is populated if the struct has a `ss:",rowid"` field.
+func MarshalRow(v interface{}, cols []Column) (r Row, err error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+
+	fields, err := rowFields(rv.Type())
+	if err != nil {
+		return
+	}
+
+	ids := make(map[string]int64, len(cols))
+	for _, col := range cols {
+		ids[col.Title] = col.ID
+	}
+
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.rowID {
+			r.ID = fv.Int()
+			continue
+		}
+
+		id, exists := ids[f.title]
+		if !exists {
+			err = errors.Errorf("Column '%v' does not exist in the sheet", f.title)
+			return
+		}
+
+		var cv *CellValue
+		if cv, err = fieldValue(fv); err != nil {
+			err = errors.Wrapf(err, "Failed to get value for column '%v'", f.title)
+			return
+		}
+
+		r.Cells = append(r.Cells, Cell{ColumnID: id, Value: cv})
+	}
+
+	return
+}
+
+func setField(fv reflect.Value, cv *CellValue) error {
+	if fv.Kind() == reflect.Ptr {
+		p := reflect.New(fv.Type().Elem())
+		if err := setField(p.Elem(), cv); err != nil {
+			return err
+		}
+		fv.Set(p)
+		return nil
+	}
+
+	if fv.Type() == reflect.TypeOf(CellValue{}) {
+		fv.Set(reflect.ValueOf(*cv))
+		return nil
+	}
+
+	if fv.Type() == timeType {
+		s := cv.String()
+		if s == "" {
+			fv.Set(reflect.Zero(timeType))
+			return nil
+		}
+
+		t, err := time.Parse(dateLayout, s)
+		if err != nil {
+			if t, err = time.Parse(time.RFC3339, s); err != nil {
+				return errors.Wrapf(err, "Cannot parse '%v' as a date", s)
+			}
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(cv.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch {
+		case cv.IntVal != nil:
+			fv.SetInt(int64(*cv.IntVal))
+		case cv.FloatVal != nil:
+			fv.SetInt(int64(*cv.FloatVal))
+		default:
+			i, err := strconv.ParseInt(cv.String(), 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "Cannot convert '%v' to %v", cv.String(), fv.Type())
+			}
+			fv.SetInt(i)
+		}
+	case reflect.Float32, reflect.Float64:
+		switch {
+		case cv.FloatVal != nil:
+			fv.SetFloat(*cv.FloatVal)
+		case cv.IntVal != nil:
+			fv.SetFloat(float64(*cv.IntVal))
+		default:
+			f, err := strconv.ParseFloat(cv.String(), 64)
+			if err != nil {
+				return errors.Wrapf(err, "Cannot convert '%v' to %v", cv.String(), fv.Type())
+			}
+			fv.SetFloat(f)
+		}
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cv.String())
+		if err != nil {
+			return errors.Wrapf(err, "Cannot convert '%v' to bool", cv.String())
+		}
+		fv.SetBool(b)
+	default:
+		return errors.Errorf("Unsupported field type %v", fv.Type())
+	}
+
+	return nil
+}
+
+func fieldValue(fv reflect.Value) (*CellValue, error) {
+	cv := &CellValue{}
+
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			cv.SetString("") //clears the cell on updates
+			return cv, nil
+		}
+		fv = fv.Elem()
+	}
+
+	if fv.Type() == reflect.TypeOf(CellValue{}) {
+		v := fv.Interface().(CellValue)
+		return &v, nil
+	}
+
+	if fv.Type() == timeType {
+		t := fv.Interface().(time.Time)
+		switch {
+		case t.IsZero():
+			cv.SetString("")
+		case t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0:
+			//midnight within the location of the value is a date
+			cv.SetString(t.Format(dateLayout))
+		default:
+			cv.SetString(t.Format(time.RFC3339))
+		}
+		return cv, nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		cv.SetString(fv.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		cv.SetInt(int(fv.Int()))
+	case reflect.Float32, reflect.Float64:
+		cv.SetFloat(fv.Float())
+	case reflect.Bool:
+		cv.Value = json.RawMessage(strconv.FormatBool(fv.Bool()))
+	default:
+		return nil, errors.Errorf("Unsupported field type %v", fv.Type())
+	}
+
+	return cv, nil
+}
@@ -0,0 +1,84 @@
+package goSmartSheet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type marshalTask struct {
+	RowID  int64 `ss:",rowid"`
+	Name   string
+	Count  int       `ss:"Item Count"`
+	Cost   *float64  `ss:"Cost"`
+	Due    time.Time `ss:"Due Date"`
+	Done   bool      `ss:"Done"`
+	Ignore string    `ss:"-"`
+}
+
+var marshalCols = []Column{
+	{ID: 1, Title: "Name"},
+	{ID: 2, Title: "Item Count"},
+	{ID: 3, Title: "Cost"},
+	{ID: 4, Title: "Due Date"},
+	{ID: 5, Title: "Done"},
+}
+
+func TestMarshalRow_RoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	cost := 12.5
+	in := marshalTask{
+		RowID:  42,
+		Name:   "Build",
+		Count:  3,
+		Cost:   &cost,
+		Due:    time.Date(2017, 5, 22, 0, 0, 0, 0, time.UTC),
+		Done:   true,
+		Ignore: "skip",
+	}
+
+	r, err := MarshalRow(in, marshalCols)
+	assert.NoError(err)
+	assert.Equal(int64(42), r.ID)
+	assert.Len(r.Cells, 5)
+	assert.Equal("2017-05-22", r.Cells[3].Value.String())
+	assert.Equal("true", r.Cells[4].Value.String())
+
+	var out marshalTask
+	assert.NoError(UnmarshalRow(&r, marshalCols, &out))
+	in.Ignore = ""
+	assert.Equal(in, out)
+}
+
+func TestMarshalRow_MissingColumn(t *testing.T) {
+	_, err := MarshalRow(marshalTask{}, marshalCols[:2])
+	assert.Error(t, err)
+}
+
+func TestUnmarshalRow_EmptyCells(t *testing.T) {
+	assert := assert.New(t)
+
+	r := Row{ID: 7, Cells: []Cell{{ColumnID: 1}}}
+	out := marshalTask{Name: "stale", Count: 9}
+	assert.NoError(UnmarshalRow(&r, marshalCols, &out))
+	assert.Equal(marshalTask{RowID: 7}, out)
+
+	assert.Error(UnmarshalRow(&r, marshalCols, out))
+}
+
+func TestMarshalRow_DateInLocation(t *testing.T) {
+	assert := assert.New(t)
+
+	loc := time.FixedZone("AEST", 10*60*60)
+	in := marshalTask{Due: time.Date(2017, 5, 22, 0, 0, 0, 0, loc)}
+	r, err := MarshalRow(in, marshalCols)
+	assert.NoError(err)
+	assert.Equal("2017-05-22", r.Cells[3].Value.String())
+
+	in.Due = time.Date(2017, 5, 22, 10, 0, 0, 0, loc)
+	r, err = MarshalRow(in, marshalCols)
+	assert.NoError(err)
+	assert.Equal("2017-05-22T10:00:00+10:00", r.Cells[3].Value.String())
+}
@@ -0,0 +1,247 @@
+package goSmartSheet
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Request is a single attempt of a request passing through the middleware chain
+type Request struct {
+	// HTTP is the request which will be sent, middleware may change its headers.
+	// The Authorization header is only added by the innermost handler.
+	HTTP *http.Request
+	// Verb is the HTTP method and Path is relative to the API URL, such as sheets/1?include=discussions
+	Verb string
+	Path string
+	// Target is the type the response, or the result within it, is decoded into.  It is nil when the caller
+	// decodes the response itself, such as with Get or PostObject.
+	Target reflect.Type
+	// Attempt is the attempt number of the request starting at 1
+	Attempt int
+}
+
+// RequestResult is the result of a request.  Body is nil when an error is returned, otherwise
+// the body must be read and closed by whoever consumes it.
+type RequestResult struct {
+	StatusCode int
+	Header     http.Header
+	Body       io.ReadCloser
+}
+
+// Handler sends a request.  Unsuccessful responses are returned as an *ErrorItem along with the RequestResult,
+// which is nil when the request could not be sent.
+type Handler func(req *Request) (*RequestResult, error)
+
+// Middleware wraps a Handler to add behavior to every request such as metrics, auditing or header injection
+//
+//	client.Use(func(next Handler) Handler {
+//		return func(req *Request) (*RequestResult, error) {
+//			resp, err := next(req)
+//			...
+//			return resp, err
+//		}
+//	})
+type Middleware func(next Handler) Handler
+
+// Use adds the middleware to the client, the first middleware is the outermost.  Clients derived
+// afterwards through WithOptions or AsUser share the middleware.
+func (c *Client) Use(mw ...Middleware) {
+	c.middleware = append(append([]Middleware(nil), c.middleware...), mw...)
+}
+
+// handler returns the chain of middleware ending with send
+func (c *Client) handler() Handler {
+	h := Handler(c.send)
+
+	if l, opt := c.logger(); l != nil {
+		h = Logging(l, opt)(h)
+	}
+
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+
+	return h
+}
+
+// retry returns a copy of the request for the next attempt, with the body rewound
+func (req *Request) retry() (*Request, error) {
+	h := req.HTTP.Clone(req.HTTP.Context())
+	if req.HTTP.Body != nil && req.HTTP.Body != http.NoBody {
+		if req.HTTP.GetBody == nil {
+			return nil, errors.New("Request body cannot be replayed")
+		}
+
+		body, err := req.HTTP.GetBody()
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to replay request body")
+		}
+		h.Body = body
+	}
+
+	return &Request{HTTP: h, Verb: req.Verb, Path: req.Path, Target: req.Target, Attempt: req.Attempt + 1}, nil
+}
+
+// RetryOptions controls the Retry middleware
+type RetryOptions struct {
+	// MaxAttempts is the total number of attempts including the first, defaults to 4
+	MaxAttempts int
+	// BaseDelay is doubled after every attempt, defaults to 500ms.  A Retry-After header takes precedence.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts, defaults to 30s
+	MaxDelay time.Duration
+	// RetryWrites also retries POST, PUT and DELETE requests after failures which may have been processed by the
+	// server, only set it when every write made through the client is safe to repeat
+	RetryWrites bool
+}
+
+// Retry returns a middleware which retries failed requests using exponential backoff with jitter.
+// Requests which were not processed, such as when rate limited, are retried whatever their verb.  Other failures,
+// including failures to send the request and server errors such as 503 or error code 4000, may have been applied
+// by the server, so they are only retried for GET requests unless RetryOptions.RetryWrites is set.
+// Requests with streamed bodies, such as attachment uploads, are never retried.
+func Retry(opt RetryOptions) Middleware {
+	if opt.MaxAttempts <= 0 {
+		opt.MaxAttempts = 4
+	}
+	if opt.BaseDelay <= 0 {
+		opt.BaseDelay = 500 * time.Millisecond
+	}
+	if opt.MaxDelay <= 0 {
+		opt.MaxDelay = 30 * time.Second
+	}
+
+	return func(next Handler) Handler {
+		return func(req *Request) (*RequestResult, error) {
+			for {
+				resp, err := next(req)
+				if err == nil || req.Attempt >= opt.MaxAttempts || !opt.shouldRetry(req, resp, err) {
+					return resp, err
+				}
+
+				retry, rerr := req.retry()
+				if rerr != nil {
+					return resp, err
+				}
+
+				if werr := wait(req.HTTP.Context(), opt.delay(req.Attempt, resp)); werr != nil {
+					return resp, err
+				}
+
+				req = retry
+			}
+		}
+	}
+}
+
+func (opt RetryOptions) shouldRetry(req *Request, resp *RequestResult, err error) bool {
+	//requests which may have reached the server are only repeated when they have no side effects
+	repeatable := req.Verb == http.MethodGet || opt.RetryWrites
+
+	if resp == nil {
+		return repeatable && req.HTTP.Context().Err() == nil
+	}
+	return IsRetryable(err) || (repeatable && isTransient(err))
+}
+
+// transientCodes are the error codes of failures which may succeed when retried, but the request may have been processed
+var transientCodes = map[int]bool{
+	ErrorCodeUnexpected:      true,
+	ErrorCodeServerTimeout:   true,
+	ErrorCodeVersionConflict: true,
+}
+
+// isTransient returns true when err is a server failure which may succeed when retried
+func isTransient(err error) bool {
+	var e *ErrorItem
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	switch e.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return transientCodes[e.ErrorCode]
+}
+
+// delay returns the time to wait after the attempt
+func (opt RetryOptions) delay(attempt int, resp *RequestResult) time.Duration {
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+			d := time.Duration(secs) * time.Second
+			if d > opt.MaxDelay {
+				d = opt.MaxDelay
+			}
+			return d
+		}
+	}
+
+	d := opt.BaseDelay << uint(attempt-1)
+	if d <= 0 || d > opt.MaxDelay {
+		d = opt.MaxDelay
+	}
+
+	//up to 50% jitter so concurrent clients do not retry in step
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// DefaultRateLimit is the number of requests per minute allowed for each access token
+const DefaultRateLimit = 300
+
+// RateLimit returns a middleware which spaces requests so no more than perMinute are sent, which avoids
+// being rate limited by SmartSheet.  Add it after Retry so each attempt is limited.  The limit is shared
+// by every client using the returned middleware.
+func RateLimit(perMinute int) Middleware {
+	if perMinute <= 0 {
+		perMinute = DefaultRateLimit
+	}
+	interval := time.Minute / time.Duration(perMinute)
+
+	var mu sync.Mutex
+	var nextSlot time.Time
+
+	return func(next Handler) Handler {
+		return func(req *Request) (*RequestResult, error) {
+			mu.Lock()
+			now := time.Now()
+			if nextSlot.Before(now) {
+				nextSlot = now
+			}
+			d := nextSlot.Sub(now)
+			nextSlot = nextSlot.Add(interval)
+			mu.Unlock()
+
+			if err := wait(req.HTTP.Context(), d); err != nil {
+				return nil, err
+			}
+
+			return next(req)
+		}
+	}
+}
+
+// wait blocks for the duration or until the context is done
+func wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
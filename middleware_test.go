@@ -0,0 +1,209 @@
+package goSmartSheet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingCredentials struct {
+	n int32
+}
+
+func (c *countingCredentials) AccessToken(ctx context.Context) (string, error) {
+	return "token" + strconv.Itoa(int(atomic.AddInt32(&c.n, 1))), nil
+}
+
+func TestClient_Middleware(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int32
+	var bodies, bearers, injected []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		bearers = append(bearers, r.Header.Get("Authorization"))
+		injected = append(injected, r.Header.Get("X-Audit"))
+
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			io.WriteString(w, `{"errorCode":4003,"message":"Rate limit exceeded."}`)
+			return
+		}
+		io.WriteString(w, `{"resultCode":0,"result":[]}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClientWithCredentials(&countingCredentials{}, srv.URL+"/2.0")
+	assert.NoError(err)
+
+	var seen []string
+	c.Use(
+		func(next Handler) Handler {
+			return func(req *Request) (*RequestResult, error) {
+				resp, err := next(req)
+				seen = append(seen, req.Verb+" "+req.Path)
+				return resp, err
+			}
+		},
+		Retry(RetryOptions{BaseDelay: time.Millisecond}),
+		func(next Handler) Handler {
+			return func(req *Request) (*RequestResult, error) {
+				req.HTTP.Header.Set("X-Audit", "attempt "+strconv.Itoa(req.Attempt))
+				return next(req)
+			}
+		},
+	)
+
+	assert.NoError(c.updateRows("1", []Row{{ID: 5}}))
+
+	//the body is replayed and the credentials are asked for on each attempt
+	body := `[{"id":5,"cells":null}]` + "\n"
+	assert.Equal([]string{body, body, body}, bodies)
+	assert.Equal([]string{"Bearer token1", "Bearer token2", "Bearer token3"}, bearers)
+	assert.Equal([]string{"attempt 1", "attempt 2", "attempt 3"}, injected)
+	assert.Equal([]string{"PUT sheets/1/rows"}, seen)
+
+	//retries stop after MaxAttempts
+	atomic.StoreInt32(&calls, -10)
+	d := c.WithOptions()
+	d.middleware = nil
+	d.Use(Retry(RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	err = d.updateRows("1", []Row{{ID: 5}})
+	assert.True(errors.Is(err, ErrRateLimited))
+	assert.Equal(int32(-8), atomic.LoadInt32(&calls))
+}
+
+func TestClient_MiddlewareTarget(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /2.0/sheets/1/columns", "POST /2.0/sheets/1/rows":
+			io.WriteString(w, `{"resultCode":0,"result":[{"id":2}]}`)
+		case "PUT /2.0/sheets/1/columns/2", "POST /2.0/webhooks":
+			io.WriteString(w, `{"resultCode":0,"result":{"id":2}}`)
+		default:
+			io.WriteString(w, `{"id":1}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	var targets []reflect.Type
+	var status []int
+	c.Use(func(next Handler) Handler {
+		return func(req *Request) (*RequestResult, error) {
+			resp, err := next(req)
+			targets = append(targets, req.Target)
+			status = append(status, resp.StatusCode)
+			return resp, err
+		}
+	})
+
+	_, err = c.GetSheet("1", "")
+	assert.NoError(err)
+	_, err = c.GetJSONString("sheets/1", false)
+	assert.NoError(err)
+
+	//helpers which decode the result set the target too
+	_, err = c.AddColumn("1", Column{Title: "Name", Type: "TEXT_NUMBER"})
+	assert.NoError(err)
+	title := "Title"
+	_, err = c.UpdateColumn("1", 2, UpdateColumnRequest{Title: &title})
+	assert.NoError(err)
+	_, err = c.CreateWebhook(Webhook{Name: "Sync", ScopeObjectID: 1, CallbackURL: "https://example.com/cb"})
+	assert.NoError(err)
+	body, err := c.AddRowsToSheet("1", ToBottom, []Row{{Cells: []Cell{{ColumnID: 2}}}}, NormalValidation)
+	assert.NoError(err)
+	body.Close()
+	body, err = c.PostObject("sheets/1/rows", []Row{})
+	assert.NoError(err)
+	body.Close()
+
+	assert.Equal([]reflect.Type{
+		reflect.TypeOf(&Sheet{}), nil,
+		reflect.TypeOf(&[]Column{}), reflect.TypeOf(&Column{}), reflect.TypeOf(&Webhook{}), reflect.TypeOf(&[]Row{}), nil,
+	}, targets)
+	assert.Equal([]int{200, 200, 200, 200, 200, 200, 200}, status)
+}
+
+func TestRetry_Writes(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		if len(requests)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, `{"errorCode":4000,"message":"An unexpected error has occurred"}`)
+			return
+		}
+		io.WriteString(w, `{"resultCode":0,"result":[]}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+	c.Use(Retry(RetryOptions{BaseDelay: time.Millisecond}))
+
+	//the write may have been applied, so it is not repeated
+	body, err := c.AddRowsToSheet("1", ToBottom, []Row{{Cells: []Cell{{ColumnID: 2}}}}, NormalValidation)
+	assert.True(errors.Is(err, ErrUnexpected))
+	assert.Nil(body)
+	assert.Equal([]string{"POST"}, requests)
+
+	//reads are repeated
+	requests = nil
+	rc, _, err := c.Get("sheets/1")
+	assert.NoError(err)
+	rc.Close()
+	assert.Equal([]string{"GET", "GET"}, requests)
+
+	//writes are repeated when the caller opts in
+	requests = nil
+	d := c.WithOptions()
+	d.middleware = nil
+	d.Use(Retry(RetryOptions{BaseDelay: time.Millisecond, RetryWrites: true}))
+	body, err = d.AddRowsToSheet("1", ToBottom, []Row{{Cells: []Cell{{ColumnID: 2}}}}, NormalValidation)
+	assert.NoError(err)
+	body.Close()
+	assert.Equal([]string{"POST", "POST"}, requests)
+}
+
+func TestRateLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"id":1}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+	c.Use(RateLimit(60 * 50)) //one request every 20ms
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err = c.GetSheet("1", "")
+		assert.NoError(err)
+	}
+	assert.True(time.Since(start) >= 60*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.GetSheetWithContext(ctx, "1", "")
+	assert.True(errors.Is(err, context.Canceled))
+}
@@ -0,0 +1,349 @@
+package goSmartSheet
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// OAuth access scopes
+// https://smartsheet-platform.github.io/api-docs/#access-scopes
+const (
+	ScopeAdminSheets     = "ADMIN_SHEETS"
+	ScopeAdminSights     = "ADMIN_SIGHTS"
+	ScopeAdminUsers      = "ADMIN_USERS"
+	ScopeAdminWebhooks   = "ADMIN_WEBHOOKS"
+	ScopeAdminWorkspaces = "ADMIN_WORKSPACES"
+	ScopeCreateSheets    = "CREATE_SHEETS"
+	ScopeCreateSights    = "CREATE_SIGHTS"
+	ScopeDeleteSheets    = "DELETE_SHEETS"
+	ScopeDeleteSights    = "DELETE_SIGHTS"
+	ScopeReadContacts    = "READ_CONTACTS"
+	ScopeReadEvents      = "READ_EVENTS"
+	ScopeReadSheets      = "READ_SHEETS"
+	ScopeReadSights      = "READ_SIGHTS"
+	ScopeReadUsers       = "READ_USERS"
+	ScopeShareSheets     = "SHARE_SHEETS"
+	ScopeShareSights     = "SHARE_SIGHTS"
+	ScopeWriteSheets     = "WRITE_SHEETS"
+)
+
+// tokenExpiryDelta is how long before expiry a token is refreshed
+const tokenExpiryDelta = 5 * time.Minute
+
+// OAuthConfig describes an OAuth application registered with SmartSheet
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	//RedirectURL is optional when the application only has a single redirect URL registered
+	RedirectURL string
+	Scopes      []string
+
+	//Region the application is registered within, defaults to RegionUS
+	Region Region
+	//AuthURL and TokenURL override the endpoints derived from the Region
+	AuthURL  string
+	TokenURL string
+
+	//HTTPClient is used for token requests, defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// Token is an OAuth access token along with the refresh token used to renew it
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid returns true when the token is set and not about to expire
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+// tokenResponse is returned by the token endpoint, which uses OAuth style errors for some failures
+type tokenResponse struct {
+	Token
+	ExpiresIn        int64  `json:"expires_in"`
+	ErrorCode        int    `json:"errorCode"`
+	Message          string `json:"message"`
+	RefID            string `json:"refId"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (cfg *OAuthConfig) authURL() string {
+	if cfg.AuthURL == "" {
+		return cfg.Region.AuthURL()
+	}
+	return cfg.AuthURL
+}
+
+func (cfg *OAuthConfig) tokenURL() string {
+	if cfg.TokenURL == "" {
+		return cfg.Region.TokenURL()
+	}
+	return cfg.TokenURL
+}
+
+func (cfg *OAuthConfig) httpClient() *http.Client {
+	if cfg.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return cfg.HTTPClient
+}
+
+// AuthCodeURL returns the URL the user is sent to in order to authorize the application.
+// state is returned unchanged to the redirect URL and should be used to protect against CSRF.
+func (cfg *OAuthConfig) AuthCodeURL(state string) string {
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", cfg.ClientID)
+	q.Set("scope", strings.Join(cfg.Scopes, " "))
+	if state != "" {
+		q.Set("state", state)
+	}
+	if cfg.RedirectURL != "" {
+		q.Set("redirect_uri", cfg.RedirectURL)
+	}
+
+	return cfg.authURL() + "?" + q.Encode()
+}
+
+// hash is the SHA-256 of the client secret and the code or refresh token, sent in place of the secret
+func (cfg *OAuthConfig) hash(value string) string {
+	sum := sha256.Sum256([]byte(cfg.ClientSecret + "|" + value))
+	return hex.EncodeToString(sum[:])
+}
+
+// Exchange exchanges the authorization code returned to the redirect URL for a token
+func (cfg *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("client_id", cfg.ClientID)
+	v.Set("hash", cfg.hash(code))
+	if cfg.RedirectURL != "" {
+		v.Set("redirect_uri", cfg.RedirectURL)
+	}
+
+	t, err := cfg.tokenRequest(ctx, v)
+	return t, errors.Wrap(err, "Failed to exchange authorization code")
+}
+
+// Refresh uses the refresh token to get a new token, the previous token is no longer valid afterwards
+func (cfg *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "refresh_token")
+	v.Set("refresh_token", refreshToken)
+	v.Set("client_id", cfg.ClientID)
+	v.Set("hash", cfg.hash(refreshToken))
+	if cfg.RedirectURL != "" {
+		v.Set("redirect_uri", cfg.RedirectURL)
+	}
+
+	t, err := cfg.tokenRequest(ctx, v)
+	return t, errors.Wrap(err, "Failed to refresh token")
+}
+
+func (cfg *OAuthConfig) tokenRequest(ctx context.Context, v url.Values) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.tokenURL(), strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create token request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := cfg.httpClient().Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to POST token request")
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read token response")
+	}
+
+	tr := &tokenResponse{}
+	if err = json.Unmarshal(b, tr); err != nil {
+		if !isSuccess(resp.StatusCode) {
+			return nil, errorItemFromBody(resp.StatusCode, b)
+		}
+		return nil, errors.Wrapf(err, "Failed to decode token response, status code: %v", resp.StatusCode)
+	}
+
+	switch {
+	case tr.ErrorCode != 0:
+		return nil, &ErrorItem{ErrorCode: tr.ErrorCode, Message: tr.Message, RefID: tr.RefID, StatusCode: resp.StatusCode}
+	case tr.Error != "":
+		return nil, errors.Errorf("OAuth error %v: %v", tr.Error, tr.ErrorDescription)
+	case !isSuccess(resp.StatusCode) || tr.AccessToken == "":
+		return nil, errors.Errorf("Token request failed, status code: %v", resp.StatusCode)
+	}
+
+	t := tr.Token
+	if tr.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return &t, nil
+}
+
+// Revoke revokes the access token and its refresh token.  When all is true every token issued to the
+// application for the user is revoked.
+func (cfg *OAuthConfig) Revoke(ctx context.Context, accessToken string, all bool) error {
+	u := cfg.tokenURL()
+	if all {
+		u += "?deleteAllForApiClient=true"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create revoke request")
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := cfg.httpClient().Do(req)
+	if err != nil {
+		return errors.Wrap(err, "Failed to revoke token")
+	}
+
+	body, err := checkResponse(resp.StatusCode, resp.Body)
+	if err == nil {
+		err = decodeResult(body)
+	}
+	return errors.Wrap(err, "Failed to revoke token")
+}
+
+// TokenSource supplies the OAuth token used by a client
+type TokenSource interface {
+	// Token returns a valid token, refreshing it when required
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenStore persists tokens so they survive restarts and can be shared between processes
+type TokenStore interface {
+	// Load returns the saved token, or nil when there is none
+	Load(ctx context.Context) (*Token, error)
+	// Save persists the token, this is called whenever the token is refreshed
+	Save(ctx context.Context, t *Token) error
+}
+
+// MemoryTokenStore keeps the token in memory
+type MemoryTokenStore struct {
+	mu sync.Mutex
+	t  *Token
+}
+
+// Load returns the token held in memory
+func (m *MemoryTokenStore) Load(ctx context.Context) (*Token, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.t, nil
+}
+
+// Save replaces the token held in memory
+func (m *MemoryTokenStore) Save(ctx context.Context, t *Token) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.t = t
+	return nil
+}
+
+// OAuthTokenSource is a TokenSource which refreshes the token within the store before it expires
+type OAuthTokenSource struct {
+	cfg   *OAuthConfig
+	store TokenStore
+
+	mu sync.Mutex
+	t  *Token
+}
+
+// NewOAuthTokenSource returns a TokenSource for the token within the store, which must already contain a token
+// such as one returned by Exchange
+func NewOAuthTokenSource(cfg *OAuthConfig, store TokenStore) *OAuthTokenSource {
+	return &OAuthTokenSource{cfg: cfg, store: store}
+}
+
+// Token returns the current token, refreshing and saving it when it is about to expire
+func (s *OAuthTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.t.Valid() {
+		return s.t, nil
+	}
+
+	//another process may have refreshed the token already
+	t, err := s.store.Load(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to load token")
+	}
+	if t == nil {
+		return nil, errors.New("No token within the token store")
+	}
+	if t.Valid() {
+		s.t = t
+		return t, nil
+	}
+
+	if t.RefreshToken == "" {
+		return nil, errors.New("Token has expired and cannot be refreshed")
+	}
+
+	if t, err = s.cfg.Refresh(ctx, t.RefreshToken); err != nil {
+		return nil, err
+	}
+
+	if err = s.store.Save(ctx, t); err != nil {
+		return nil, errors.Wrap(err, "Failed to save refreshed token")
+	}
+
+	s.t = t
+	return t, nil
+}
+
+// Revoke revokes the current token and clears it from the store
+func (s *OAuthTokenSource) Revoke(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.t
+	if t == nil {
+		var err error
+		if t, err = s.store.Load(ctx); err != nil {
+			return errors.Wrap(err, "Failed to load token")
+		}
+	}
+	if t == nil {
+		return nil
+	}
+
+	if err := s.cfg.Revoke(ctx, t.AccessToken, false); err != nil {
+		return err
+	}
+
+	s.t = nil
+	return s.store.Save(ctx, nil)
+}
+
+// GetClientWithTokenSource returns a client which asks the TokenSource for the bearer token of each request
+func GetClientWithTokenSource(ts TokenSource, u string) (*Client, error) {
+	if ts == nil {
+		return nil, errors.New("TokenSource must be provided")
+	}
+
+	return GetClientWithCredentials(TokenSourceCredentials(ts), u)
+}
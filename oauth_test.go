@@ -0,0 +1,120 @@
+package goSmartSheet
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuthConfig_AuthCodeURL(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := &OAuthConfig{ClientID: "app", Scopes: []string{ScopeReadSheets, ScopeWriteSheets}}
+	u, err := url.Parse(cfg.AuthCodeURL("xyz"))
+	assert.NoError(err)
+	assert.Equal("app.smartsheet.com", u.Host)
+	assert.Equal("/b/authorize", u.Path)
+	assert.Equal("code", u.Query().Get("response_type"))
+	assert.Equal("app", u.Query().Get("client_id"))
+	assert.Equal("READ_SHEETS WRITE_SHEETS", u.Query().Get("scope"))
+	assert.Equal("xyz", u.Query().Get("state"))
+}
+
+func TestOAuthConfig_Endpoints(t *testing.T) {
+	assert := assert.New(t)
+
+	//the endpoints are derived from the region, which defaults to the US
+	cfg := &OAuthConfig{ClientID: "app"}
+	assert.Equal("https://app.smartsheet.com/b/authorize", cfg.authURL())
+	assert.Equal("https://api.smartsheet.com/2.0/token", cfg.tokenURL())
+
+	cfg.Region = RegionEU
+	assert.Contains(cfg.AuthCodeURL(""), "https://app.smartsheet.eu/b/authorize?")
+	assert.Equal("https://api.smartsheet.eu/2.0/token", cfg.tokenURL())
+
+	cfg.AuthURL = "https://example.com/authorize"
+	cfg.TokenURL = "https://example.com/token"
+	assert.Equal("https://example.com/authorize", cfg.authURL())
+	assert.Equal("https://example.com/token", cfg.tokenURL())
+}
+
+func TestOAuthTokenSource(t *testing.T) {
+	assert := assert.New(t)
+
+	hash := func(v string) string {
+		sum := sha256.Sum256([]byte("secret|" + v))
+		return hex.EncodeToString(sum[:])
+	}
+
+	var bearers []string
+	revoked := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/2.0/token" && r.Method == "POST":
+			r.ParseForm()
+			switch r.Form.Get("grant_type") {
+			case "authorization_code":
+				assert.Equal(hash("code1"), r.Form.Get("hash"))
+				io.WriteString(w, `{"access_token":"a1","token_type":"bearer","refresh_token":"r1","expires_in":60}`)
+			case "refresh_token":
+				assert.Equal(hash("r1"), r.Form.Get("hash"))
+				io.WriteString(w, `{"access_token":"a2","token_type":"bearer","refresh_token":"r2","expires_in":604799}`)
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				io.WriteString(w, `{"error":"invalid_grant","error_description":"bad"}`)
+			}
+		case r.URL.Path == "/2.0/token" && r.Method == "DELETE":
+			revoked = r.Header.Get("Authorization") == "Bearer a2"
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		default:
+			bearers = append(bearers, r.Header.Get("Authorization"))
+			io.WriteString(w, `{"id":1}`)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &OAuthConfig{ClientID: "app", ClientSecret: "secret", TokenURL: srv.URL + "/2.0/token"}
+	ctx := context.Background()
+
+	tok, err := cfg.Exchange(ctx, "code1")
+	assert.NoError(err)
+	assert.Equal("a1", tok.AccessToken)
+	assert.WithinDuration(time.Now().Add(time.Minute), tok.Expiry, 5*time.Second)
+	//expires within the refresh window
+	assert.False(tok.Valid())
+
+	store := &MemoryTokenStore{}
+	assert.NoError(store.Save(ctx, tok))
+
+	ts := NewOAuthTokenSource(cfg, store)
+	c, err := GetClientWithTokenSource(ts, srv.URL+"/2.0")
+	assert.NoError(err)
+
+	_, err = c.GetSheet("1", "")
+	assert.NoError(err)
+	_, err = c.GetSheet("1", "")
+	assert.NoError(err)
+	assert.Equal([]string{"Bearer a2", "Bearer a2"}, bearers)
+
+	saved, _ := store.Load(ctx)
+	assert.Equal("r2", saved.RefreshToken)
+
+	assert.NoError(ts.Revoke(ctx))
+	assert.True(revoked)
+	saved, _ = store.Load(ctx)
+	assert.Nil(saved)
+
+	_, err = c.GetSheet("1", "")
+	assert.Error(err)
+
+	_, err = cfg.tokenRequest(ctx, url.Values{"grant_type": {"other"}})
+	assert.EqualError(err, "OAuth error invalid_grant: bad")
+}
@@ -0,0 +1,48 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPageSize is the number of items requested per page when reading every page of a list
+const DefaultPageSize = 100
+
+// getAllPages reads every page of a paginated list endpoint decoding the data of each page into T
+func getAllPages[T any](c *Client, path string) ([]T, error) {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	items := []T{}
+	for page := 1; ; page++ {
+		resp, err := c.getPage(path + sep + "pageSize=" + strconv.Itoa(DefaultPageSize) + "&page=" + strconv.Itoa(page))
+		if err != nil {
+			return nil, err
+		}
+
+		var data []T
+		if err = json.Unmarshal(resp.Data, &data); err != nil {
+			return nil, errors.Wrapf(err, "Call seems successful, but failed to decode into %T", data)
+		}
+		items = append(items, data...)
+
+		if resp.PageNumber >= resp.TotalPages || len(data) == 0 {
+			return items, nil
+		}
+	}
+}
+
+// getPage reads a single page from a paginated list endpoint
+func (c *Client) getPage(path string) (*PaginatedResponse, error) {
+	resp := &PaginatedResponse{}
+	if err := c.getObject(path, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,437 @@
+package goSmartSheet
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Operator is a comparison used when querying a sheet
+type Operator int
+
+const (
+	// Eq matches cells equal to the value
+	Eq Operator = iota
+	// Ne matches cells not equal to the value
+	Ne
+	// EqFold matches cells equal to the value ignoring case
+	EqFold
+	// Lt matches cells less than the value
+	Lt
+	// Le matches cells less than or equal to the value
+	Le
+	// Gt matches cells greater than the value
+	Gt
+	// Ge matches cells greater than or equal to the value
+	Ge
+	// Before matches dates before the value, it is the same as Lt
+	Before
+	// After matches dates after the value, it is the same as Gt
+	After
+	// Contains matches cells containing the value as a substring
+	Contains
+	// Matches matches cells against a regular expression given as a string or *regexp.Regexp
+	Matches
+	// IsNull matches blank cells, the value is ignored
+	IsNull
+	// NotNull matches cells with a value, the value is ignored
+	NotNull
+)
+
+// AnyColumn can be used as the column of a condition to match when any cell within the row satisfies it
+const AnyColumn = ""
+
+// SortOrder is the direction used by Query.OrderBy
+type SortOrder int
+
+const (
+	// Ascending sorts smallest first
+	Ascending SortOrder = iota
+	// Descending sorts largest first
+	Descending
+)
+
+type condition struct {
+	colID int64 //0 for any column
+	op    Operator
+	value interface{}
+	re    *regexp.Regexp
+}
+
+type sortKey struct {
+	colID int64
+	order SortOrder
+}
+
+// Query filters and sorts the rows of a loaded Sheet.  Results point back into the Sheet so changes made
+// through them are reflected on the Sheet.
+//
+//	rows := s.Where("Status", Eq, "Done").And("Due", Before, time.Now()).OrderBy("Due", Ascending).Rows()
+//
+// Values are compared based on their type: int, int64 and float64 compare numerically, time.Time compares
+// dates and strings compare against the DisplayValue when present, otherwise the Value of the cell.
+type Query struct {
+	sheet *Sheet
+	conds []condition
+	sorts []sortKey
+	err   error
+}
+
+// Where starts a query over the sheet with the first condition
+func (s *Sheet) Where(column string, op Operator, value interface{}) *Query {
+	q := &Query{sheet: s}
+	return q.And(column, op, value)
+}
+
+// And adds another condition which rows must satisfy
+func (q *Query) And(column string, op Operator, value interface{}) *Query {
+	if q.err != nil {
+		return q
+	}
+
+	cond := condition{op: op, value: value}
+	if column != AnyColumn {
+		if cond.colID, q.err = q.columnID(column); q.err != nil {
+			return q
+		}
+	}
+
+	if op == Matches {
+		switch v := value.(type) {
+		case *regexp.Regexp:
+			cond.re = v
+		case string:
+			if cond.re, q.err = regexp.Compile(v); q.err != nil {
+				q.err = errors.Wrapf(q.err, "Invalid expression for column '%v'", column)
+				return q
+			}
+		default:
+			q.err = errors.Errorf("Matches requires a string or *regexp.Regexp, got %T", value)
+			return q
+		}
+	}
+
+	q.conds = append(q.conds, cond)
+	return q
+}
+
+// OrderBy sorts the results by the column.  Multiple calls sort by each column in turn.
+func (q *Query) OrderBy(column string, order SortOrder) *Query {
+	if q.err != nil {
+		return q
+	}
+
+	var id int64
+	if id, q.err = q.columnID(column); q.err == nil {
+		q.sorts = append(q.sorts, sortKey{colID: id, order: order})
+	}
+
+	return q
+}
+
+// Err returns the first error encountered while building the query, such as an unknown column
+func (q *Query) Err() error {
+	return q.err
+}
+
+// Rows returns the matching rows.  Nil is returned if the query is invalid.
+func (q *Query) Rows() []*Row {
+	if q.err != nil {
+		return nil
+	}
+
+	rows := []*Row{}
+	for i := range q.sheet.Rows {
+		r := &q.sheet.Rows[i]
+		if q.match(r) {
+			rows = append(rows, r)
+		}
+	}
+
+	if len(q.sorts) > 0 {
+		sort.SliceStable(rows, func(i, j int) bool {
+			for _, k := range q.sorts {
+				a, b := cellFor(rows[i], k.colID), cellFor(rows[j], k.colID)
+
+				//blank cells sort last whatever the direction
+				if ab, bb := cellText(a) == "", cellText(b) == ""; ab != bb {
+					return bb
+				}
+
+				c := compareCells(a, b)
+				if c == 0 {
+					continue
+				}
+				if k.order == Descending {
+					return c > 0
+				}
+				return c < 0
+			}
+			return false
+		})
+	}
+
+	return rows
+}
+
+// Cells returns the cell within the column for each matching row.  Rows without the cell are skipped.
+func (q *Query) Cells(column string) []*Cell {
+	if q.err != nil {
+		return nil
+	}
+
+	id, err := q.columnID(column)
+	if err != nil {
+		q.err = err
+		return nil
+	}
+
+	cells := []*Cell{}
+	for _, r := range q.Rows() {
+		if c := cellFor(r, id); c != nil {
+			cells = append(cells, c)
+		}
+	}
+
+	return cells
+}
+
+// First returns the first matching row
+func (q *Query) First() (*Row, bool) {
+	rows := q.Rows()
+	if len(rows) == 0 {
+		return nil, false
+	}
+	return rows[0], true
+}
+
+// Count returns the number of matching rows
+func (q *Query) Count() int {
+	return len(q.Rows())
+}
+
+func (q *Query) columnID(title string) (int64, error) {
+	for _, col := range q.sheet.Columns {
+		if col.Title == title {
+			return col.ID, nil
+		}
+	}
+	return 0, errors.Errorf("Column '%v' does not exist in sheet", title)
+}
+
+func (q *Query) match(r *Row) bool {
+	for _, cond := range q.conds {
+		if cond.colID != 0 {
+			if !cond.match(cellFor(r, cond.colID)) {
+				return false
+			}
+			continue
+		}
+
+		found := false
+		for i := range r.Cells {
+			if cond.match(&r.Cells[i]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func cellFor(r *Row, colID int64) *Cell {
+	for i := range r.Cells {
+		if r.Cells[i].ColumnID == colID {
+			return &r.Cells[i]
+		}
+	}
+	return nil
+}
+
+// cellText returns the DisplayValue when present, otherwise the value as a string
+func cellText(c *Cell) string {
+	if c == nil {
+		return ""
+	}
+	if c.DisplayValue != "" {
+		return c.DisplayValue
+	}
+	return c.Value.normalized()
+}
+
+// cellNumber returns the numeric value of the cell
+func cellNumber(c *Cell) (float64, bool) {
+	if c == nil || c.Value == nil {
+		return 0, false
+	}
+
+	switch {
+	case c.Value.IntVal != nil:
+		return float64(*c.Value.IntVal), true
+	case c.Value.FloatVal != nil:
+		return *c.Value.FloatVal, true
+	}
+
+	f, err := strconv.ParseFloat(c.Value.normalized(), 64)
+	return f, err == nil
+}
+
+// cellTime returns the date value of the cell
+func cellTime(c *Cell) (time.Time, bool) {
+	if c == nil || c.Value == nil {
+		return time.Time{}, false
+	}
+
+	s := c.Value.normalized()
+	if t, err := time.Parse(dateLayout, s); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func (cond *condition) match(c *Cell) bool {
+	switch cond.op {
+	case IsNull:
+		return cellText(c) == ""
+	case NotNull:
+		return cellText(c) != ""
+	case Matches:
+		return c != nil && cond.re.MatchString(cellText(c))
+	case Contains:
+		return c != nil && strings.Contains(cellText(c), toText(cond.value))
+	case EqFold:
+		return c != nil && strings.EqualFold(cellText(c), toText(cond.value))
+	}
+
+	cmp, ok := compareValue(c, cond.value)
+	if !ok {
+		return cond.op == Ne
+	}
+
+	switch cond.op {
+	case Eq:
+		return cmp == 0
+	case Ne:
+		return cmp != 0
+	case Lt, Before:
+		return cmp < 0
+	case Le:
+		return cmp <= 0
+	case Gt, After:
+		return cmp > 0
+	case Ge:
+		return cmp >= 0
+	}
+
+	return false
+}
+
+func toText(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case int:
+		return strconv.Itoa(t)
+	case int64:
+		return strconv.FormatInt(t, 10)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case time.Time:
+		return t.Format(dateLayout)
+	case *CellValue:
+		return t.normalized()
+	}
+	return ""
+}
+
+// compareValue compares the cell to v returning -1, 0 or 1 and false when they cannot be compared
+func compareValue(c *Cell, v interface{}) (int, bool) {
+	if c == nil || c.Value == nil {
+		return 0, false
+	}
+
+	var want float64
+	switch t := v.(type) {
+	case time.Time:
+		got, ok := cellTime(c)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case got.Before(t):
+			return -1, true
+		case got.After(t):
+			return 1, true
+		}
+		return 0, true
+	case int:
+		want = float64(t)
+	case int64:
+		want = float64(t)
+	case float64:
+		want = t
+	case *CellValue:
+		return strings.Compare(c.Value.normalized(), t.normalized()), true
+	default:
+		return strings.Compare(cellText(c), toText(v)), true
+	}
+
+	got, ok := cellNumber(c)
+	if !ok {
+		return 0, false
+	}
+
+	switch {
+	case got < want:
+		return -1, true
+	case got > want:
+		return 1, true
+	}
+	return 0, true
+}
+
+// compareCells orders two cells using numbers, then dates, then text.  Blank cells sort last.
+func compareCells(a, b *Cell) int {
+	at, bt := cellText(a), cellText(b)
+	switch {
+	case at == "" && bt == "":
+		return 0
+	case at == "":
+		return 1
+	case bt == "":
+		return -1
+	}
+
+	if an, ok := cellNumber(a); ok {
+		if bn, ok := cellNumber(b); ok {
+			switch {
+			case an < bn:
+				return -1
+			case an > bn:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	if ad, ok := cellTime(a); ok {
+		if bd, ok := cellTime(b); ok {
+			return ad.Compare(bd)
+		}
+	}
+
+	return strings.Compare(at, bt)
+}
@@ -0,0 +1,80 @@
+package goSmartSheet
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func querySheet() *Sheet {
+	row := func(id int64, status string, due string, count int) Row {
+		var sv, dv, cv CellValue
+		sv.SetString(status)
+		dv.SetString(due)
+		cv.SetInt(count)
+
+		r := Row{ID: id, Cells: []Cell{{ColumnID: 1, Value: &sv, DisplayValue: status}, {ColumnID: 3, Value: &cv}}}
+		if due != "" {
+			r.Cells = append(r.Cells, Cell{ColumnID: 2, Value: &dv})
+		}
+		return r
+	}
+
+	return &Sheet{
+		Columns: []Column{{ID: 1, Title: "Status"}, {ID: 2, Title: "Due"}, {ID: 3, Title: "Count"}},
+		Rows: []Row{
+			row(10, "Done", "2017-05-01", 5),
+			row(11, "Open", "2017-06-01", 2),
+			row(12, "Done", "2017-04-01", 10),
+			row(13, "Done", "", 1),
+		},
+	}
+}
+
+func TestQuery(t *testing.T) {
+	assert := assert.New(t)
+	s := querySheet()
+
+	cutoff := time.Date(2017, 5, 15, 0, 0, 0, 0, time.UTC)
+	rows := s.Where("Status", Eq, "Done").And("Due", Before, cutoff).OrderBy("Due", Ascending).Rows()
+	assert.Len(rows, 2)
+	assert.Equal(int64(12), rows[0].ID)
+	assert.Equal(int64(10), rows[1].ID)
+
+	//results point back to the sheet
+	rows[0].Locked = true
+	assert.True(s.Rows[2].Locked)
+
+	assert.Equal(3, s.Where("Count", Ge, 2).Count())
+	assert.Equal(2, s.Where("Count", Gt, 2.5).Count())
+	assert.Equal(1, s.Where("Due", IsNull, nil).Count())
+	assert.Equal(1, s.Where(AnyColumn, Matches, regexp.MustCompile("^Op")).Count())
+	assert.Equal(3, s.Where("Status", EqFold, "done").Count())
+	assert.Equal(1, s.Where("Status", Ne, "Done").Count())
+
+	rows = s.Where("Status", NotNull, nil).OrderBy("Count", Descending).Rows()
+	assert.Equal(int64(12), rows[0].ID)
+
+	rows = s.Where("Status", NotNull, nil).OrderBy("Due", Descending).Rows()
+	assert.Equal([]int64{11, 10, 12, 13}, []int64{rows[0].ID, rows[1].ID, rows[2].ID, rows[3].ID})
+
+	cells := s.Where("Status", Eq, "Open").Cells("Count")
+	assert.Len(cells, 1)
+	assert.Equal(2, cells[0].Value.Int())
+
+	q := s.Where("Missing", Eq, "x")
+	assert.Error(q.Err())
+	assert.Nil(q.Rows())
+}
+
+func TestSheet_FindValue(t *testing.T) {
+	assert := assert.New(t)
+	s := querySheet()
+
+	r, c, exists := s.FindValue("Open")
+	assert.True(exists)
+	assert.Same(&s.Rows[1], r)
+	assert.Same(&s.Rows[1].Cells[0], c)
+}
@@ -0,0 +1,131 @@
+package goSmartSheet
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Region is a SmartSheet data center, accounts only exist within a single region.
+// The zero Region is RegionUS.
+type Region string
+
+// The regions of SmartSheet
+const (
+	RegionUS  Region = "US"
+	RegionEU  Region = "EU"
+	RegionGov Region = "Gov"
+)
+
+type hosts struct {
+	//api serves the REST API and the OAuth token endpoint
+	api string
+	//app serves the web application, permalinks and the OAuth authorize page
+	app string
+}
+
+// regionHosts are the hosts of each region
+var regionHosts = map[Region]hosts{
+	RegionUS:  {api: "api.smartsheet.com", app: "app.smartsheet.com"},
+	RegionEU:  {api: "api.smartsheet.eu", app: "app.smartsheet.eu"},
+	RegionGov: {api: "api.smartsheetgov.com", app: "app.smartsheetgov.com"},
+}
+
+// Regions returns every known region
+func Regions() []Region {
+	return []Region{RegionUS, RegionEU, RegionGov}
+}
+
+// orDefault returns RegionUS for the zero Region
+func (r Region) orDefault() Region {
+	if r == "" {
+		return RegionUS
+	}
+	return r
+}
+
+// APIHost returns the host serving the REST API of the region
+func (r Region) APIHost() string {
+	return regionHosts[r.orDefault()].api
+}
+
+// AppHost returns the host serving the web application of the region
+func (r Region) AppHost() string {
+	return regionHosts[r.orDefault()].app
+}
+
+// BaseURL returns the URL of the 2.0 API within the region
+func (r Region) BaseURL() string {
+	return "https://" + r.APIHost() + "/2.0"
+}
+
+// AuthURL returns the OAuth authorize URL of the region
+func (r Region) AuthURL() string {
+	return "https://" + r.AppHost() + "/b/authorize"
+}
+
+// TokenURL returns the OAuth token URL of the region
+func (r Region) TokenURL() string {
+	return r.BaseURL() + "/token"
+}
+
+// ValidatePermalink returns an error unless the link is an https link to the web application of the region
+func (r Region) ValidatePermalink(link string) error {
+	r = r.orDefault()
+	if r.AppHost() == "" {
+		return errors.Errorf("Unknown region '%v'", r)
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return errors.Wrapf(err, "Invalid permalink '%v'", link)
+	}
+
+	if u.Scheme != "https" || !strings.EqualFold(u.Hostname(), r.AppHost()) {
+		return errors.Errorf("Permalink '%v' does not belong to the %v region (%v)", link, r, r.AppHost())
+	}
+
+	return nil
+}
+
+// RegionForURL returns the region serving the API or web application URL
+func RegionForURL(u string) (Region, bool) {
+	p, err := url.Parse(u)
+	if err != nil {
+		return "", false
+	}
+
+	host := p.Hostname()
+	for _, r := range Regions() {
+		if strings.EqualFold(host, r.APIHost()) || strings.EqualFold(host, r.AppHost()) {
+			return r, true
+		}
+	}
+	return "", false
+}
+
+// GetClientForRegion returns a client for the API of the region
+//
+//	client, err := GetClientForRegion(EnvCredentials{}, RegionEU)
+func GetClientForRegion(p CredentialProvider, r Region) (*Client, error) {
+	return GetClientWithCredentials(p, r.BaseURL())
+}
+
+// Region returns the region of the client, false is returned when the client uses a URL outside of every region
+func (c *Client) Region() (Region, bool) {
+	return RegionForURL(c.url)
+}
+
+// ValidatePermalink returns an error unless the link belongs to the region of the client.
+// When the client URL is outside of every region only the URL itself is validated.
+func (c *Client) ValidatePermalink(link string) error {
+	if r, ok := c.Region(); ok {
+		return r.ValidatePermalink(link)
+	}
+
+	if _, err := url.ParseRequestURI(link); err != nil {
+		return errors.Wrapf(err, "Invalid permalink '%v'", link)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package goSmartSheet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegion(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("https://api.smartsheet.eu/2.0", RegionEU.BaseURL())
+	assert.Equal("https://app.smartsheetgov.com/b/authorize", RegionGov.AuthURL())
+	assert.Equal("https://api.smartsheetgov.com/2.0/token", RegionGov.TokenURL())
+	assert.Equal(RegionUS.TokenURL(), Region("").TokenURL())
+
+	assert.Equal([]Region{RegionUS, RegionEU, RegionGov}, Regions())
+	assert.Error(Region("APAC").ValidatePermalink("https://app.smartsheet.com/sheets/abc"))
+
+	c, err := GetClientForRegion(StaticCredentials("key"), RegionEU)
+	assert.NoError(err)
+	r, ok := c.Region()
+	assert.True(ok)
+	assert.Equal(RegionEU, r)
+
+	assert.NoError(c.ValidatePermalink("https://app.smartsheet.eu/sheets/abc"))
+	assert.Error(c.ValidatePermalink("https://app.smartsheet.com/sheets/abc"))
+	assert.Error(c.ValidatePermalink("http://app.smartsheet.eu/sheets/abc"))
+
+	c, err = GetClient("key", "")
+	assert.NoError(err)
+	r, ok = c.Region()
+	assert.True(ok)
+	assert.Equal(RegionUS, r)
+
+	c, err = GetClient("key", "http://localhost/2.0")
+	assert.NoError(err)
+	_, ok = c.Region()
+	assert.False(ok)
+	assert.NoError(c.ValidatePermalink("http://localhost/sheets/abc"))
+}
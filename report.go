@@ -0,0 +1,146 @@
+package goSmartSheet
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Report is a view of rows from one or more source sheets
+// https://smartsheet-platform.github.io/api-docs/#report-object
+type Report struct {
+	ID              int64     `json:"id"`
+	Name            string    `json:"name"`
+	TotalRowCount   int       `json:"totalRowCount"`
+	AccessLevel     string    `json:"accessLevel"`
+	Permalink       string    `json:"permalink"`
+	IsSummaryReport bool      `json:"isSummaryReport,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+	ModifiedAt      time.Time `json:"modifiedAt"`
+
+	//Columns are identified by VirtualID, Rows contain the ID of their source sheet
+	Columns []Column `json:"columns"`
+	Rows    []Row    `json:"rows"`
+
+	//only populated when requested via include=sourceSheets
+	SourceSheets []Sheet `json:"sourceSheets,omitempty"`
+}
+
+// ReportOptions are the paging and include options used when getting a report
+type ReportOptions struct {
+	Page     int
+	PageSize int
+	Include  []string
+}
+
+func (o *ReportOptions) query() string {
+	if o == nil {
+		return ""
+	}
+
+	q := url.Values{}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		q.Set("pageSize", strconv.Itoa(o.PageSize))
+	}
+	if len(o.Include) > 0 {
+		q.Set("include", strings.Join(o.Include, ","))
+	}
+
+	if len(q) == 0 {
+		return ""
+	}
+	return "?" + q.Encode()
+}
+
+// ListReports returns every report the user has access to.  Only the id, name and access level are populated.
+func (c *Client) ListReports() ([]Report, error) {
+	return getAllPages[Report](c, "reports")
+}
+
+// GetReport returns the report with the specified id.  opt may be nil to get the first page of rows.
+func (c *Client) GetReport(id string, opt *ReportOptions) (*Report, error) {
+	r := &Report{}
+	if err := c.getObject("reports/"+id+opt.query(), r); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get report (ID: %v)", id)
+	}
+
+	return r, nil
+}
+
+// GetReportAsCSV returns a stream of the report formatted as CSV, the caller must close it
+func (c *Client) GetReportAsCSV(id string) (io.ReadCloser, error) {
+	return c.getReportAs(id, "text/csv")
+}
+
+// GetReportAsExcel returns a stream of the report formatted as an Excel workbook, the caller must close it
+func (c *Client) GetReportAsExcel(id string) (io.ReadCloser, error) {
+	return c.getReportAs(id, "application/vnd.ms-excel")
+}
+
+func (c *Client) getReportAs(id, accept string) (io.ReadCloser, error) {
+	body, _, err := c.doInto(context.Background(), nil, "GET", "reports/"+id, nil, map[string]string{"Accept": accept})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to get report (ID: %v)", id)
+	}
+
+	return body, nil
+}
+
+// SourceRows maps the report rows back to their source sheets.  The returned rows are keyed by source sheet ID
+// and only contain the row ID and the cells, using the column IDs of the source sheet, so they can be changed
+// and passed to UpdateRowsOnSheet.
+func (r *Report) SourceRows() map[int64][]Row {
+	sheets := make(map[int64][]Row)
+	for _, row := range r.Rows {
+		src := Row{ID: row.ID}
+		for _, c := range row.Cells {
+			if c.ColumnID == 0 {
+				continue //cells such as the sheet name do not exist on the source sheet
+			}
+			src.Cells = append(src.Cells, Cell{ColumnID: c.ColumnID, Value: c.Value, DisplayValue: c.DisplayValue})
+		}
+		sheets[row.SheetID] = append(sheets[row.SheetID], src)
+	}
+
+	return sheets
+}
+
+// UpdateReportRows will update each row on its source sheet.  Rows must have their SheetID populated
+// and cells must use the column IDs of the source sheet, as returned by a report.
+func (c *Client) UpdateReportRows(rows []Row) error {
+	var order []int64
+	sheets := make(map[int64][]Row)
+	for _, r := range rows {
+		if r.SheetID == 0 {
+			return errors.Errorf("Row %v is missing its source sheet ID", r.ID)
+		}
+
+		if _, exists := sheets[r.SheetID]; !exists {
+			order = append(order, r.SheetID)
+		}
+
+		src := Row{ID: r.ID}
+		for _, cell := range r.Cells {
+			if cell.ColumnID != 0 {
+				src.Cells = append(src.Cells, Cell{ColumnID: cell.ColumnID, Value: cell.Value})
+			}
+		}
+		sheets[r.SheetID] = append(sheets[r.SheetID], src)
+	}
+
+	for _, id := range order {
+		if err := c.updateRows(strconv.FormatInt(id, 10), sheets[id]); err != nil {
+			return errors.Wrapf(err, "Failed to update rows on source sheet %v", id)
+		}
+	}
+
+	return nil
+}
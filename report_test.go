@@ -0,0 +1,69 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Reports(t *testing.T) {
+	assert := assert.New(t)
+
+	put := map[string][]Row{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.Header.Get("Accept") == "text/csv":
+			io.WriteString(w, "Name\nA\n")
+		case r.Method == "GET" && r.URL.Path == "/2.0/reports/5":
+			assert.Equal("include=sourceSheets&page=2&pageSize=10", r.URL.RawQuery)
+			io.WriteString(w, `{"id":5,"name":"Rollup",
+				"columns":[{"virtualId":100,"title":"Name"},{"virtualId":101,"title":"Sheet Name","sheetNameColumn":true}],
+				"rows":[
+					{"id":1,"sheetId":7,"cells":[{"columnId":70,"virtualColumnId":100,"value":"A"},{"virtualColumnId":101,"value":"Sheet 7"}]},
+					{"id":2,"sheetId":8,"cells":[{"columnId":80,"virtualColumnId":100,"value":"B"}]}],
+				"sourceSheets":[{"id":7,"name":"Sheet 7"},{"id":8,"name":"Sheet 8"}]}`)
+		case r.Method == "PUT":
+			var rows []Row
+			json.NewDecoder(r.Body).Decode(&rows)
+			put[r.URL.Path] = rows
+			io.WriteString(w, `{"resultCode":0,"result":[]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	r, err := c.GetReport("5", &ReportOptions{Page: 2, PageSize: 10, Include: []string{"sourceSheets"}})
+	assert.NoError(err)
+	assert.Len(r.SourceSheets, 2)
+	assert.True(r.Columns[1].SheetNameColumn)
+	assert.Equal(int64(100), r.Rows[0].Cells[0].VirtualColumnID)
+
+	src := r.SourceRows()
+	assert.Len(src, 2)
+	assert.Len(src[7][0].Cells, 1)
+	assert.Equal(int64(70), src[7][0].Cells[0].ColumnID)
+
+	r.Rows[0].Cells[0].Value.SetString("changed")
+	assert.NoError(c.UpdateReportRows(r.Rows))
+	assert.Len(put, 2)
+	assert.Equal("changed", put["/2.0/sheets/7/rows"][0].Cells[0].Value.String())
+	assert.Len(put["/2.0/sheets/7/rows"][0].Cells, 1)
+
+	csv, err := c.GetReportAsCSV("5")
+	assert.NoError(err)
+	b, _ := io.ReadAll(csv)
+	csv.Close()
+	assert.Equal("Name\nA\n", string(b))
+
+	_, err = c.GetReport("6", nil)
+	assert.Error(err)
+}
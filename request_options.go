@@ -0,0 +1,84 @@
+package goSmartSheet
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// RequestOption changes a request before it is sent, such as adding a header.
+//
+// Options are applied to every request of a derived client through WithOptions, which is how options are
+// applied to the typed helpers such as ListUsers or UpdateRowsOnSheet.  Deriving a client is cheap, so a
+// client can be derived for a single call.  A context from ContextWithOptions only applies to the methods
+// taking a context: GetSheetWithContext, GetWithContext, PostWithContext, PutWithContext, DeleteWithContext,
+// DownloadAttachmentWithContext, Watch, Events and Enricher.Run.  Every other method makes its requests with a
+// background context.
+type RequestOption func(req *http.Request)
+
+// WithHeader sets the header on the request
+func WithHeader(key, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set(key, value)
+	}
+}
+
+// WithAssumeUser makes the request on behalf of the user with the email, this requires an admin token
+func WithAssumeUser(email string) RequestOption {
+	return WithHeader("Assume-User", url.QueryEscape(email))
+}
+
+// WithChangeAgent sets the Smartsheet-Change-Agent header, which is included in the webhook callbacks
+// of changes made by the request so an integration can ignore its own changes
+func WithChangeAgent(agent string) RequestOption {
+	return WithHeader("Smartsheet-Change-Agent", agent)
+}
+
+// WithAccept sets the content type requested from the API such as text/csv.
+// Typed helpers such as GetSheet expect JSON, so this is mostly useful with Get.
+func WithAccept(contentType string) RequestOption {
+	return WithHeader("Accept", contentType)
+}
+
+// WithRequestID sets the X-Request-Id header so a request, and any retries of it, can be correlated
+func WithRequestID(id string) RequestOption {
+	return WithHeader("X-Request-Id", id)
+}
+
+// WithOptions returns a client which applies the options to every request, the original client is unchanged.
+// This is the only way to apply options to the typed helpers which do not take a context.
+//
+//	client.WithOptions(WithChangeAgent("sync")).GetSheet(id, "")
+func (c *Client) WithOptions(opts ...RequestOption) *Client {
+	d := *c
+	d.options = append(append([]RequestOption(nil), c.options...), opts...)
+	return &d
+}
+
+type requestOptionsKey struct{}
+
+// ContextWithOptions returns a context carrying the options, which are applied after the options of the client
+// to requests made with the context.  Only the methods taking a context listed on RequestOption make requests
+// with it, use WithOptions for any other method.  Options already within ctx are kept.
+//
+//	ctx = ContextWithOptions(ctx, WithRequestID(id))
+//	sheet, err := client.GetSheetWithContext(ctx, sheetID, "")
+func ContextWithOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	existing := requestOptionsFromContext(ctx)
+	return context.WithValue(ctx, requestOptionsKey{}, append(append([]RequestOption(nil), existing...), opts...))
+}
+
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	return opts
+}
+
+// applyRequestOptions applies the client options followed by those within the request context
+func applyRequestOptions(req *http.Request, clientOpts []RequestOption) {
+	for _, o := range clientOpts {
+		o(req)
+	}
+	for _, o := range requestOptionsFromContext(req.Context()) {
+		o(req)
+	}
+}
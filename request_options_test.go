@@ -0,0 +1,100 @@
+package goSmartSheet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_RequestOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	var headers []http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		io.WriteString(w, `{"id":1,"name":"Sheet"}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	agent := c.WithOptions(WithChangeAgent("sync"), WithAssumeUser("a@b.com"))
+	_, err = agent.GetSheet("1", "")
+	assert.NoError(err)
+	assert.Equal("sync", headers[0].Get("Smartsheet-Change-Agent"))
+	assert.Equal("a%40b.com", headers[0].Get("Assume-User"))
+	assert.Equal("Bearer key", headers[0].Get("Authorization"))
+
+	//context options are applied after those of the client
+	ctx := ContextWithOptions(context.Background(), WithRequestID("req-1"), WithChangeAgent("override"))
+	_, err = agent.GetSheetWithContext(ctx, "1", "")
+	assert.NoError(err)
+	assert.Equal("override", headers[1].Get("Smartsheet-Change-Agent"))
+	assert.Equal("req-1", headers[1].Get("X-Request-Id"))
+	assert.Equal("a%40b.com", headers[1].Get("Assume-User"))
+
+	//the original client is unchanged
+	_, err = c.GetSheet("1", "")
+	assert.NoError(err)
+	assert.Equal("", headers[2].Get("Smartsheet-Change-Agent"))
+	assert.Equal("", headers[2].Get("X-Request-Id"))
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.GetSheetWithContext(cancelled, "1", "")
+	assert.Error(err)
+	_, _, err = c.GetWithContext(cancelled, "sheets/1")
+	assert.Error(err)
+	assert.Len(headers, 3)
+
+	//the low level requests also apply the context options
+	body, _, err := c.GetWithContext(ctx, "sheets/1")
+	assert.NoError(err)
+	body.Close()
+	assert.Equal("req-1", headers[3].Get("X-Request-Id"))
+}
+
+func TestClient_RequestOptionsTypedHelpers(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("Assume-User")+" "+r.Header.Get("Smartsheet-Change-Agent"))
+		switch r.Method {
+		case "GET":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":1,"email":"a@b.com"}]}`)
+		default:
+			io.WriteString(w, `{"resultCode":0,"result":[]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	//a client derived for a single call applies the options to typed helpers which do not take a context
+	users, err := c.WithOptions(WithAssumeUser("a@b.com")).ListUsers()
+	assert.NoError(err)
+	assert.Len(users, 1)
+
+	body, err := c.WithOptions(WithChangeAgent("sync")).UpdateRowsOnSheet("1", []Row{{ID: 10}})
+	assert.NoError(err)
+	body.Close()
+
+	assert.NoError(c.WithOptions(WithChangeAgent("sync")).DeleteWebhook(7))
+
+	_, err = c.ListUsers()
+	assert.NoError(err)
+
+	assert.Equal([]string{
+		"GET /2.0/users a%40b.com ",
+		"PUT /2.0/sheets/1/rows  sync",
+		"DELETE /2.0/webhooks/7  sync",
+		"GET /2.0/users  ",
+	}, requests)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 
 	"time"
 
@@ -87,12 +89,14 @@ Example Response:
 */
 
 //ErrorItem reprsents a single failure during an operation
+//Use errors.Is with the sentinels such as ErrNotFound to check the ErrorCode
 type ErrorItem struct {
 	ErrorCode  int               `json:"errorCode"`
 	Message    string            `json:"message"`
 	RefID      string            `json:"refId,omitempty"`
 	Details    []ErrorItemDetail `json:"details,omitempty"`
 	StatusCode int               `json:"-"` //not part of SS API, but used to provide extra context
+	Body       string            `json:"-"` //the raw body when it is not an ErrorItem, such as HTML from a proxy
 }
 
 //String returns a string representation of an ErrorItem for output purposes
@@ -105,22 +109,100 @@ func (e *ErrorItem) Error() string {
 	return fmt.Sprintf("Error (%v): %s", e.StatusCode, e.String())
 }
 
+//maxErrorBody limits how much of an error response is read
+const maxErrorBody = 64 << 10
+
+//maxErrorMessage limits how much of a non JSON error body is used as the message
+const maxErrorMessage = 512
+
 //ErrorItemDecode translates the SmartSheet ErrorItem into a Go erorr
+//When the body is not an ErrorItem the buffered body is kept within ErrorItem.Body
 func ErrorItemDecode(statusCode int, bodyDec *json.Decoder) error {
 	e := &ErrorItem{}
-	if err := bodyDec.Decode(e); err != nil {
-		return errors.Wrap(err, "Failed to decode into ErrorItem")
+	if err := bodyDec.Decode(e); err != nil || (e.ErrorCode == 0 && e.Message == "") {
+		b, _ := io.ReadAll(io.LimitReader(bodyDec.Buffered(), maxErrorBody))
+		return errorItemFromBody(statusCode, b)
 	}
 	e.StatusCode = statusCode
 	return e
 }
 
 //ErrorItemDecodeFromReader translates the SmartSheet ErrorItem into a Go erorr taking a ReadCloser
+//When the body is not an ErrorItem it is kept within ErrorItem.Body
 func ErrorItemDecodeFromReader(statusCode int, body io.ReadCloser) error {
-	bodyDec := json.NewDecoder(body)
 	defer body.Close()
 
-	return ErrorItemDecode(statusCode, bodyDec)
+	b, err := io.ReadAll(io.LimitReader(body, maxErrorBody))
+	if err != nil {
+		return errors.Wrapf(err, "Failed to read error response, status code: %v", statusCode)
+	}
+
+	e := &ErrorItem{}
+	if err = json.Unmarshal(b, e); err != nil || (e.ErrorCode == 0 && e.Message == "") {
+		return errorItemFromBody(statusCode, b)
+	}
+	e.StatusCode = statusCode
+	return e
+}
+
+//errorItemFromBody creates an ErrorItem for a response which is not an ErrorItem
+func errorItemFromBody(statusCode int, b []byte) *ErrorItem {
+	e := &ErrorItem{StatusCode: statusCode, Body: string(b), Message: http.StatusText(statusCode)}
+
+	if text := strings.TrimSpace(string(b)); text != "" {
+		if len(text) > maxErrorMessage {
+			text = text[:maxErrorMessage] + "..."
+		}
+		e.Message += ": " + text
+	}
+
+	return e
+}
+
+//isSuccess returns true for every 2xx status code
+func isSuccess(statusCode int) bool {
+	return statusCode >= 200 && statusCode < 300
+}
+
+//checkResponse is where every response is validated.  Unsuccessful responses are translated into an
+//ErrorItem and their body is closed, successful bodies are returned for the caller to decode and close.
+func checkResponse(statusCode int, body io.ReadCloser) (io.ReadCloser, error) {
+	if isSuccess(statusCode) {
+		return body, nil
+	}
+
+	if body == nil {
+		return nil, &ErrorItem{StatusCode: statusCode, Message: http.StatusText(statusCode)}
+	}
+	return nil, ErrorItemDecodeFromReader(statusCode, body)
+}
+
+//decodeInto decodes a successful JSON body into v, closing the body
+func decodeInto(body io.ReadCloser, v interface{}) error {
+	defer body.Close()
+
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return errors.Wrapf(err, "Failed to decode into object %T", v)
+	}
+	return nil
+}
+
+//decodeResult decodes a successful body containing only a result code, closing the body
+func decodeResult(body io.ReadCloser) error {
+	r := &ResultResponse{}
+	if err := decodeInto(body, r); err != nil {
+		return err
+	}
+
+	return r.check()
+}
+
+//check returns an error when the result code is not success
+func (r *ResultResponse) check() error {
+	if r.ResultCode != 0 {
+		return errors.Errorf("Result Code returned non-success: %v, Message: %v", r.ResultCode, r.Message)
+	}
+	return nil
 }
 
 //ErrorItemDetail is the detail for a single failure
@@ -0,0 +1,73 @@
+package goSmartSheet
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ResponseHandling(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/2.0/proxy":
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			io.WriteString(w, "<html><body>502 Bad Gateway</body></html>")
+		case r.URL.Path == "/2.0/missing":
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/created":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"resultCode":0,"result":{"id":5}}`)
+		case r.URL.Path == "/2.0/failed":
+			io.WriteString(w, `{"resultCode":3,"message":"PARTIAL_SUCCESS","result":{}}`)
+		default:
+			io.WriteString(w, `{"id":1}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	//non JSON bodies are preserved
+	_, err = c.GetJSONString("proxy", false)
+	var item *ErrorItem
+	assert.True(errors.As(err, &item))
+	assert.Equal(http.StatusBadGateway, item.StatusCode)
+	assert.Equal("<html><body>502 Bad Gateway</body></html>", item.Body)
+	assert.True(strings.HasPrefix(item.Message, "Bad Gateway: <html>"))
+	assert.False(IsRetryable(err))
+
+	_, err = c.GetJSONString("missing", false)
+	assert.True(errors.Is(err, ErrNotFound))
+
+	s, err := c.GetJSONString("ok", false)
+	assert.NoError(err)
+	assert.Equal(`{"id":1}`, s)
+
+	//every 2xx status is a success
+	body, err := c.PostObject("created", struct{}{})
+	assert.NoError(err)
+	var created Sheet
+	assert.NoError(decodeAsResultResponseInto(body, &created))
+	assert.Equal(int64(5), created.ID)
+
+	//a non-zero result code is an error
+	body, err = c.PutObject("failed", struct{}{})
+	assert.NoError(err)
+	err = decodeAsResultResponseInto(body, &created)
+	assert.EqualError(err, "Result Code returned non-success: 3, Message: PARTIAL_SUCCESS")
+
+	body, statusCode, err := c.Delete("missing")
+	assert.Nil(body)
+	assert.Equal(http.StatusNotFound, statusCode)
+	assert.True(errors.Is(err, ErrNotFound))
+}
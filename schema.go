@@ -0,0 +1,298 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Schema is the declarative column layout of a sheet.  It can be written as either YAML or JSON.
+//
+//	columns:
+//	  - title: Task
+//	    type: TEXT_NUMBER
+//	    primary: true
+//	  - title: Status
+//	    type: PICKLIST
+//	    options: [Open, Done]
+//	    width: 120
+type Schema struct {
+	Columns []ColumnSchema `json:"columns" yaml:"columns"`
+}
+
+// ColumnSchema is the desired state of a single column.  Columns are matched to the sheet by Title.
+type ColumnSchema struct {
+	Title   string   `json:"title" yaml:"title"`
+	Type    string   `json:"type" yaml:"type"`
+	Options []string `json:"options,omitempty" yaml:"options,omitempty"`
+	Width   int      `json:"width,omitempty" yaml:"width,omitempty"`
+	Primary bool     `json:"primary,omitempty" yaml:"primary,omitempty"`
+	Formula string   `json:"formula,omitempty" yaml:"formula,omitempty"`
+}
+
+// ParseSchema decodes a YAML or JSON schema and validates it
+func ParseSchema(b []byte) (*Schema, error) {
+	s := &Schema{}
+	if err := yaml.Unmarshal(b, s); err != nil {
+		return nil, errors.Wrap(err, "Failed to decode schema")
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ReadSchema reads a YAML or JSON schema from r
+func ReadSchema(r io.Reader) (*Schema, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read schema")
+	}
+
+	return ParseSchema(b)
+}
+
+// Validate checks that the schema has unique titles and exactly one primary column
+func (s *Schema) Validate() error {
+	titles := make(map[string]bool, len(s.Columns))
+	primaries := 0
+	for i, col := range s.Columns {
+		if col.Title == "" || col.Type == "" {
+			return errors.Errorf("Column %v must have a title and type", i)
+		}
+
+		if titles[col.Title] {
+			return errors.Errorf("Duplicate column title '%v'", col.Title)
+		}
+		titles[col.Title] = true
+
+		if col.Primary {
+			primaries++
+		}
+	}
+
+	if primaries != 1 {
+		return errors.Errorf("Schema must have exactly one primary column, found %v", primaries)
+	}
+
+	return nil
+}
+
+// SchemaChangeType is the kind of change made to a column when applying a schema
+type SchemaChangeType string
+
+const (
+	// ColumnAdd inserts a new column
+	ColumnAdd SchemaChangeType = "add"
+	// ColumnUpdate changes the properties or position of an existing column
+	ColumnUpdate SchemaChangeType = "update"
+	// ColumnDelete removes a column and all of its data
+	ColumnDelete SchemaChangeType = "delete"
+)
+
+// SchemaChange is a single column operation within a SchemaPlan
+type SchemaChange struct {
+	Type SchemaChangeType
+	// Column is the desired column, ID is populated for updates and deletes
+	Column Column
+	// Fields lists the changed properties of an update
+	Fields []string
+	// Update contains only the changed properties of an update, a removed formula or options is sent as empty
+	Update UpdateColumnRequest
+	// Destructive is true when the change can lose data within the sheet
+	Destructive bool
+}
+
+// SchemaPlan is the ordered list of changes required to make a sheet match a schema
+type SchemaPlan struct {
+	SheetID string
+	Changes []SchemaChange
+}
+
+// Destructive returns true when any change within the plan can lose data
+func (p *SchemaPlan) Destructive() bool {
+	for _, c := range p.Changes {
+		if c.Destructive {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns a human readable report of the plan
+func (p *SchemaPlan) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "Sheet %v: %v column changes\n", p.SheetID, len(p.Changes))
+
+	for _, c := range p.Changes {
+		mark := ""
+		if c.Destructive {
+			mark = " (destructive)"
+		}
+
+		switch c.Type {
+		case ColumnAdd:
+			fmt.Fprintf(b, "+ add column '%v' (%v) at %v\n", c.Column.Title, c.Column.Type, c.Column.Index)
+		case ColumnUpdate:
+			fmt.Fprintf(b, "~ update column '%v': %v%v\n", c.Column.Title, strings.Join(c.Fields, ", "), mark)
+		case ColumnDelete:
+			fmt.Fprintf(b, "- delete column '%v'%v\n", c.Column.Title, mark)
+		}
+	}
+
+	return b.String()
+}
+
+// SchemaApplyOptions controls how ApplySchema makes changes
+type SchemaApplyOptions struct {
+	// DryRun returns the plan without changing the sheet
+	DryRun bool
+	// AllowDestructive permits deleting columns and changing column types
+	AllowDestructive bool
+}
+
+// PlanSchema compares the schema to the columns of the sheet and returns the changes required.
+// Columns are matched by title, so renaming a column is planned as a delete and an add.
+func (c *Client) PlanSchema(sheetID string, s *Schema) (*SchemaPlan, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	cols, err := c.GetColumns(sheetID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot retrieve columns for sheetID: %v", sheetID)
+	}
+
+	return planSchema(sheetID, s, cols)
+}
+
+func planSchema(sheetID string, s *Schema, cols []Column) (*SchemaPlan, error) {
+	p := &SchemaPlan{SheetID: sheetID}
+
+	desired := make(map[string]bool, len(s.Columns))
+	for _, col := range s.Columns {
+		desired[col.Title] = true
+	}
+
+	//deletes are applied first, what remains is the order the sheet will be in
+	var order []string
+	current := make(map[string]Column, len(cols))
+	for _, col := range cols {
+		if desired[col.Title] {
+			current[col.Title] = col
+			order = append(order, col.Title)
+			continue
+		}
+
+		if col.Primary {
+			return nil, errors.Errorf("Primary column '%v' cannot be deleted", col.Title)
+		}
+		p.Changes = append(p.Changes, SchemaChange{Type: ColumnDelete, Column: col, Destructive: true})
+	}
+
+	for i, want := range s.Columns {
+		col := Column{
+			Index:   i,
+			Title:   want.Title,
+			Type:    want.Type,
+			Primary: want.Primary,
+			Width:   want.Width,
+			Options: want.Options,
+			Formula: want.Formula,
+		}
+
+		cur, exists := current[want.Title]
+		if !exists {
+			if want.Primary {
+				return nil, errors.Errorf("Primary column '%v' must already exist in the sheet", want.Title)
+			}
+
+			p.Changes = append(p.Changes, SchemaChange{Type: ColumnAdd, Column: col})
+			order = append(order[:i], append([]string{want.Title}, order[i:]...)...)
+			continue
+		}
+
+		if cur.Primary != want.Primary {
+			return nil, errors.Errorf("Changing the primary column '%v' is not supported", want.Title)
+		}
+
+		ch := SchemaChange{Type: ColumnUpdate, Column: col}
+		ch.Column.ID = cur.ID
+		ch.Column.Primary = false //primary cannot be sent on updates
+
+		if cur.Type != want.Type {
+			ch.Fields = append(ch.Fields, fmt.Sprintf("type %v -> %v", cur.Type, want.Type))
+			ch.Update.Type = &col.Type
+			ch.Destructive = true
+		}
+		if strings.Join(cur.Options, "\x00") != strings.Join(want.Options, "\x00") {
+			ch.Fields = append(ch.Fields, fmt.Sprintf("options %v -> %v", cur.Options, want.Options))
+			options := append([]string{}, want.Options...)
+			ch.Update.Options = &options
+		}
+		if want.Width != 0 && cur.Width != want.Width {
+			ch.Fields = append(ch.Fields, fmt.Sprintf("width %v -> %v", cur.Width, want.Width))
+			ch.Update.Width = &col.Width
+		}
+		if cur.Formula != want.Formula {
+			ch.Fields = append(ch.Fields, fmt.Sprintf("formula '%v' -> '%v'", cur.Formula, want.Formula))
+			ch.Update.Formula = &col.Formula
+		}
+
+		pos := 0
+		for order[pos] != want.Title {
+			pos++
+		}
+		if pos != i {
+			ch.Fields = append(ch.Fields, fmt.Sprintf("index %v -> %v", pos, i))
+			ch.Update.Index = &col.Index
+			order = append(order[:pos], order[pos+1:]...)
+			order = append(order[:i], append([]string{want.Title}, order[i:]...)...)
+		}
+
+		if len(ch.Fields) > 0 {
+			p.Changes = append(p.Changes, ch)
+		}
+	}
+
+	return p, nil
+}
+
+// ApplySchema makes the columns of the sheet match the schema and returns the plan that was applied.
+// When the plan contains destructive changes and AllowDestructive is not set, nothing is changed and an error is returned.
+func (c *Client) ApplySchema(sheetID string, s *Schema, opt SchemaApplyOptions) (*SchemaPlan, error) {
+	p, err := c.PlanSchema(sheetID, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.DryRun {
+		return p, nil
+	}
+
+	if p.Destructive() && !opt.AllowDestructive {
+		return p, errors.New("Schema contains destructive changes, set AllowDestructive to apply")
+	}
+
+	for _, ch := range p.Changes {
+		switch ch.Type {
+		case ColumnDelete:
+			err = c.DeleteColumn(sheetID, ch.Column.ID)
+		case ColumnUpdate:
+			_, err = c.UpdateColumn(sheetID, ch.Column.ID, ch.Update)
+		case ColumnAdd:
+			_, err = c.AddColumn(sheetID, ch.Column)
+		}
+
+		if err != nil {
+			return p, errors.Wrapf(err, "Failed to %v column '%v'", ch.Type, ch.Column.Title)
+		}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,187 @@
+package goSmartSheet
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSchema = `
+columns:
+  - title: Task
+    type: TEXT_NUMBER
+    primary: true
+  - title: Status
+    type: PICKLIST
+    options: [Open, Done]
+  - title: Owner
+    type: CONTACT_LIST
+    width: 150
+`
+
+func TestParseSchema(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSchema([]byte(testSchema))
+	assert.NoError(err)
+	assert.Len(s.Columns, 3)
+	assert.Equal([]string{"Open", "Done"}, s.Columns[1].Options)
+
+	js, err := ParseSchema([]byte(`{"columns":[{"title":"Task","type":"TEXT_NUMBER","primary":true}]}`))
+	assert.NoError(err)
+	assert.Equal("Task", js.Columns[0].Title)
+
+	_, err = ParseSchema([]byte(`{"columns":[{"title":"Task","type":"TEXT_NUMBER"}]}`))
+	assert.Error(err, "missing primary")
+}
+
+func TestPlanSchema(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSchema([]byte(testSchema))
+	assert.NoError(err)
+
+	cols := []Column{
+		{ID: 1, Index: 0, Title: "Task", Type: "TEXT_NUMBER", Primary: true},
+		{ID: 2, Index: 1, Title: "Old", Type: "TEXT_NUMBER"},
+		{ID: 3, Index: 2, Title: "Owner", Type: "TEXT_NUMBER", Width: 150},
+		{ID: 4, Index: 3, Title: "Status", Type: "PICKLIST", Options: []string{"Open"}},
+	}
+
+	p, err := planSchema("1", s, cols)
+	assert.NoError(err)
+	assert.True(p.Destructive())
+	assert.Len(p.Changes, 3)
+
+	assert.Equal(ColumnDelete, p.Changes[0].Type)
+	assert.Equal(int64(2), p.Changes[0].Column.ID)
+
+	assert.Equal(ColumnUpdate, p.Changes[1].Type)
+	assert.Equal(int64(4), p.Changes[1].Column.ID)
+	assert.Equal(1, p.Changes[1].Column.Index)
+	assert.False(p.Changes[1].Destructive)
+	assert.Equal(1, *p.Changes[1].Update.Index)
+	assert.Equal([]string{"Open", "Done"}, *p.Changes[1].Update.Options)
+	assert.Nil(p.Changes[1].Update.Type)
+
+	assert.Equal(ColumnUpdate, p.Changes[2].Type)
+	assert.Equal("Owner", p.Changes[2].Column.Title)
+	assert.True(p.Changes[2].Destructive)
+	assert.Equal([]string{"type TEXT_NUMBER -> CONTACT_LIST"}, p.Changes[2].Fields)
+
+	assert.Contains(p.String(), "- delete column 'Old'")
+
+	//matching layout has nothing to do
+	cols = []Column{
+		{ID: 1, Title: "Task", Type: "TEXT_NUMBER", Primary: true},
+		{ID: 4, Title: "Status", Type: "PICKLIST", Options: []string{"Open", "Done"}},
+		{ID: 3, Title: "Owner", Type: "CONTACT_LIST", Width: 150},
+	}
+	p, err = planSchema("1", s, cols)
+	assert.NoError(err)
+	assert.Empty(p.Changes)
+
+	//new columns are added in place
+	p, err = planSchema("1", s, cols[:1])
+	assert.NoError(err)
+	assert.Len(p.Changes, 2)
+	assert.Equal(ColumnAdd, p.Changes[0].Type)
+	assert.Equal(1, p.Changes[0].Column.Index)
+	assert.Equal(2, p.Changes[1].Column.Index)
+}
+
+func TestClient_Columns(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(b)))
+		switch r.Method {
+		case "POST":
+			io.WriteString(w, `{"resultCode":0,"result":[{"id":5,"index":1,"title":"Status","type":"PICKLIST"}]}`)
+		case "PUT":
+			io.WriteString(w, `{"resultCode":0,"result":{"id":5,"index":2,"title":"Status","type":"PICKLIST"}}`)
+		default:
+			io.WriteString(w, `{"resultCode":0}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	col, err := c.AddColumn("1", Column{ID: 9, Index: 1, Title: "Status", Type: "PICKLIST", Options: []string{"Open"}})
+	assert.NoError(err)
+	assert.Equal(int64(5), col.ID)
+
+	index, formula := 2, ""
+	col, err = c.UpdateColumn("1", 5, UpdateColumnRequest{Index: &index, Formula: &formula, Options: &[]string{}})
+	assert.NoError(err)
+	assert.Equal(2, col.Index)
+
+	assert.NoError(c.DeleteColumn("1", 5))
+
+	assert.Equal([]string{
+		`POST /2.0/sheets/1/columns [{"index":1,"title":"Status","type":"PICKLIST","options":["Open"]}]`,
+		`PUT /2.0/sheets/1/columns/5 {"index":2,"options":[],"formula":""}`,
+		`DELETE /2.0/sheets/1/columns/5 `,
+	}, requests)
+}
+
+func TestClient_ApplySchema(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[
+				{"id":1,"index":0,"title":"Task","type":"TEXT_NUMBER","primary":true},
+				{"id":2,"index":1,"title":"Old","type":"TEXT_NUMBER"},
+				{"id":4,"index":2,"title":"Status","type":"PICKLIST","options":["Open"],"formula":"=1"}]}`)
+			return
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(b)))
+		switch r.Method {
+		case "POST":
+			io.WriteString(w, `{"resultCode":0,"result":[{"id":6}]}`)
+		case "PUT":
+			io.WriteString(w, `{"resultCode":0,"result":{"id":4}}`)
+		default:
+			io.WriteString(w, `{"resultCode":0}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	s, err := ParseSchema([]byte(testSchema))
+	assert.NoError(err)
+
+	//deleting Old is destructive so nothing is changed without AllowDestructive
+	p, err := c.ApplySchema("1", s, SchemaApplyOptions{})
+	assert.Error(err)
+	assert.True(p.Destructive())
+	assert.Empty(requests)
+
+	p, err = c.ApplySchema("1", s, SchemaApplyOptions{DryRun: true, AllowDestructive: true})
+	assert.NoError(err)
+	assert.Len(p.Changes, 3)
+	assert.Empty(requests)
+
+	//the removed formula is cleared rather than omitted
+	_, err = c.ApplySchema("1", s, SchemaApplyOptions{AllowDestructive: true})
+	assert.NoError(err)
+	assert.Equal([]string{
+		`DELETE /2.0/sheets/1/columns/2 `,
+		`PUT /2.0/sheets/1/columns/4 {"options":["Open","Done"],"formula":""}`,
+		`POST /2.0/sheets/1/columns [{"index":2,"title":"Owner","type":"CONTACT_LIST","width":150}]`,
+	}, requests)
+}
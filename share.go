@@ -0,0 +1,118 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AccessLevel is the level of access a user or group has to a shared object
+type AccessLevel string
+
+const (
+	AccessLevelViewer      AccessLevel = "VIEWER"
+	AccessLevelEditor      AccessLevel = "EDITOR"
+	AccessLevelEditorShare AccessLevel = "EDITOR_SHARE"
+	AccessLevelAdmin       AccessLevel = "ADMIN"
+	AccessLevelOwner       AccessLevel = "OWNER"
+)
+
+// ShareType is whether a share is with a user or a group
+type ShareType string
+
+const (
+	ShareTypeUser  ShareType = "USER"
+	ShareTypeGroup ShareType = "GROUP"
+)
+
+// ShareableObject is the type of object a share applies to
+type ShareableObject string
+
+const (
+	ShareableSheet     ShareableObject = "sheets"
+	ShareableWorkspace ShareableObject = "workspaces"
+	ShareableReport    ShareableObject = "reports"
+	ShareableSight     ShareableObject = "sights"
+)
+
+// Share grants a user or group access to a sheet, workspace, report or dashboard.
+// Either Email or GroupID must be set when creating a share.
+// https://smartsheet-platform.github.io/api-docs/#share-object
+type Share struct {
+	ID          string      `json:"id,omitempty"`
+	Type        ShareType   `json:"type,omitempty"`
+	UserID      int64       `json:"userId,omitempty"`
+	GroupID     int64       `json:"groupId,omitempty"`
+	Email       string      `json:"email,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	AccessLevel AccessLevel `json:"accessLevel"`
+	Scope       string      `json:"scope,omitempty"`
+	CreatedAt   *time.Time  `json:"createdAt,omitempty"`
+	ModifiedAt  *time.Time  `json:"modifiedAt,omitempty"`
+
+	//only used when creating a share and sendEmail is true
+	Subject string `json:"subject,omitempty"`
+	Message string `json:"message,omitempty"`
+	CCMe    bool   `json:"ccMe,omitempty"`
+}
+
+func sharesPath(obj ShareableObject, id string) string {
+	return fmt.Sprintf("%v/%v/shares", obj, id)
+}
+
+// ListShares returns every share of the object
+func (c *Client) ListShares(obj ShareableObject, id string) ([]Share, error) {
+	return getAllPages[Share](c, sharesPath(obj, id))
+}
+
+// GetShare returns a single share of the object
+func (c *Client) GetShare(obj ShareableObject, id, shareID string) (*Share, error) {
+	s := &Share{}
+	if err := c.getObject(sharesPath(obj, id)+"/"+shareID, s); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get share (ID: %v)", shareID)
+	}
+
+	return s, nil
+}
+
+// Share shares the object with each of the users and groups within shares returning the created shares.
+// sendEmail controls whether an email is sent to each recipient.
+func (c *Client) Share(obj ShareableObject, id string, shares []Share, sendEmail bool) ([]Share, error) {
+	if len(shares) == 0 {
+		return nil, nil
+	}
+
+	path := fmt.Sprintf("%v?sendEmail=%v", sharesPath(obj, id), sendEmail)
+	var created []Share
+	body, err := c.postObjectInto(&created, path, shares)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to share %v %v", obj, id)
+	}
+
+	if err = decodeAsResultResponseInto(body, &created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateShare changes the access level of an existing share
+func (c *Client) UpdateShare(obj ShareableObject, id, shareID string, level AccessLevel) (*Share, error) {
+	s := &Share{}
+	body, err := c.putObjectInto(s, sharesPath(obj, id)+"/"+shareID, Share{AccessLevel: level})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to update share (ID: %v)", shareID)
+	}
+
+	if err = decodeAsResultResponseInto(body, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DeleteShare removes the share, revoking access to the object
+func (c *Client) DeleteShare(obj ShareableObject, id, shareID string) error {
+	return c.deleteObject(sharesPath(obj, id) + "/" + shareID)
+}
@@ -0,0 +1,63 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Shares(t *testing.T) {
+	assert := assert.New(t)
+
+	var posted []Share
+	var updated Share
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/2.0/workspaces/5/shares":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":"AAA","type":"USER","email":"a@b.com","accessLevel":"OWNER"}]}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/sheets/1/shares":
+			assert.Equal("sendEmail=false", r.URL.RawQuery)
+			json.NewDecoder(r.Body).Decode(&posted)
+			io.WriteString(w, `{"resultCode":0,"result":[{"id":"BBB","type":"USER","accessLevel":"EDITOR"},{"id":"CCC","type":"GROUP","accessLevel":"VIEWER"}]}`)
+		case r.Method == "PUT" && r.URL.Path == "/2.0/sights/2/shares/BBB":
+			json.NewDecoder(r.Body).Decode(&updated)
+			io.WriteString(w, `{"resultCode":0,"result":{"id":"BBB","accessLevel":"ADMIN"}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/2.0/reports/3/shares/BBB":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	shares, err := c.ListShares(ShareableWorkspace, "5")
+	assert.NoError(err)
+	assert.Len(shares, 1)
+	assert.Equal(AccessLevelOwner, shares[0].AccessLevel)
+
+	created, err := c.Share(ShareableSheet, "1", []Share{
+		{Email: "new@b.com", AccessLevel: AccessLevelEditor},
+		{GroupID: 9, AccessLevel: AccessLevelViewer},
+	}, false)
+	assert.NoError(err)
+	assert.Len(created, 2)
+	assert.Equal(ShareTypeGroup, created[1].Type)
+	assert.Equal("new@b.com", posted[0].Email)
+	assert.Equal(int64(9), posted[1].GroupID)
+
+	s, err := c.UpdateShare(ShareableSight, "2", "BBB", AccessLevelAdmin)
+	assert.NoError(err)
+	assert.Equal(AccessLevelAdmin, s.AccessLevel)
+	assert.Equal(AccessLevelAdmin, updated.AccessLevel)
+
+	assert.NoError(c.DeleteShare(ShareableReport, "3", "BBB"))
+	assert.Error(c.DeleteShare(ShareableReport, "3", "ZZZ"))
+}
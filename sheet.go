@@ -27,6 +27,10 @@ type Sheet struct {
 	ModifiedAt time.Time `json:"modifiedAt"`
 	Columns    []Column  `json:"columns"`
 	Rows       []Row     `json:"rows"`
+
+	//only populated when requested via include=attachments or include=discussions
+	Attachments []Attachment `json:"attachments,omitempty"`
+	Discussions []Discussion `json:"discussions,omitempty"`
 }
 
 //IDToA will return a string representation of the sheetId for easier usage within the SSClient
@@ -35,13 +39,13 @@ func (s *Sheet) IDToA() string {
 }
 
 //FindValue will search the rows and cols of the sheet looking for a match based on DisplayValue.
-//When a value is found, it will return true along with its row and col positions
+//When a value is found, it will return true along with the matching row and cell within the sheet
 func (s *Sheet) FindValue(val string) (r *Row, c *Cell, exists bool) {
-	for _, r := range s.Rows {
-		for _, c := range r.Cells {
-			if strings.Compare(c.DisplayValue, val) == 0 {
-				//found, remove that from our search list?
-				return &r, &c, true
+	for i := range s.Rows {
+		r = &s.Rows[i]
+		for j := range r.Cells {
+			if strings.Compare(r.Cells[j].DisplayValue, val) == 0 {
+				return r, &r.Cells[j], true
 			}
 		}
 	}
@@ -89,6 +93,11 @@ type Column struct {
 	Primary bool     `json:"primary,omitempty"`
 	Width   int      `json:"width"`
 	Options []string `json:"options,omitempty"`
+	Formula string   `json:"formula,omitempty"`
+
+	//only populated on report columns
+	VirtualID       int64 `json:"virtualId,omitempty"`
+	SheetNameColumn bool  `json:"sheetNameColumn,omitempty"`
 }
 
 //Row is a SmartSheet row
@@ -103,6 +112,13 @@ type Row struct {
 	InCriticalPath bool       `json:"inCriticalPath,omitempty"`
 	Locked         bool       `json:"locked,omitempty"`
 
+	//only populated on report rows, the ID of the source sheet
+	SheetID int64 `json:"sheetId,omitempty"`
+
+	//only populated when requested via include=attachments or include=discussions
+	Attachments []Attachment `json:"attachments,omitempty"`
+	Discussions []Discussion `json:"discussions,omitempty"`
+
 	//row attributes for location, etc
 	ToTop    bool  `json:"toTop,omitempty"`
 	ToBottom bool  `json:"toBottom,omitempty"`
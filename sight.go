@@ -0,0 +1,256 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Sight is a dashboard, known as a Sight within the API
+// https://smartsheet-platform.github.io/api-docs/#sight-object
+type Sight struct {
+	ID              int64         `json:"id"`
+	Name            string        `json:"name"`
+	AccessLevel     string        `json:"accessLevel,omitempty"`
+	Permalink       string        `json:"permalink,omitempty"`
+	BackgroundColor string        `json:"backgroundColor,omitempty"`
+	ColumnCount     int           `json:"columnCount,omitempty"`
+	Favorite        bool          `json:"favorite,omitempty"`
+	Workspace       *WorkspaceRef `json:"workspace,omitempty"`
+	Widgets         []Widget      `json:"widgets,omitempty"`
+	Source          *SightSource  `json:"source,omitempty"`
+	CreatedAt       *time.Time    `json:"createdAt,omitempty"`
+	ModifiedAt      *time.Time    `json:"modifiedAt,omitempty"`
+}
+
+// WorkspaceRef is the reduced workspace object containing a dashboard
+type WorkspaceRef struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// SightSource is the dashboard or template a dashboard was created from
+type SightSource struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
+
+// SightPublish is the publish status of a dashboard
+type SightPublish struct {
+	ReadOnlyFullEnabled      bool   `json:"readOnlyFullEnabled"`
+	ReadOnlyFullAccessibleBy string `json:"readOnlyFullAccessibleBy,omitempty"`
+	ReadOnlyFullURL          string `json:"readOnlyFullUrl,omitempty"`
+}
+
+// WidgetType is the type of a dashboard widget
+type WidgetType string
+
+const (
+	WidgetTypeChart        WidgetType = "CHART"
+	WidgetTypeGridGantt    WidgetType = "GRIDGANTT"
+	WidgetTypeImage        WidgetType = "IMAGE"
+	WidgetTypeMetric       WidgetType = "METRIC"
+	WidgetTypeRichText     WidgetType = "RICHTEXT"
+	WidgetTypeShortcut     WidgetType = "SHORTCUT"
+	WidgetTypeShortcutIcon WidgetType = "SHORTCUTICON"
+	WidgetTypeShortcutList WidgetType = "SHORTCUTLIST"
+	WidgetTypeTitle        WidgetType = "TITLE"
+	WidgetTypeWebContent   WidgetType = "WEBCONTENT"
+)
+
+// Widget is a single element of a dashboard, use DecodeContents for its typed contents
+// https://smartsheet-platform.github.io/api-docs/#widget-object
+type Widget struct {
+	ID            int64           `json:"id"`
+	Type          WidgetType      `json:"type"`
+	Title         string          `json:"title,omitempty"`
+	TitleFormat   string          `json:"titleFormat,omitempty"`
+	ShowTitle     bool            `json:"showTitle,omitempty"`
+	ShowTitleIcon bool            `json:"showTitleIcon,omitempty"`
+	XPosition     int             `json:"xPosition"`
+	YPosition     int             `json:"yPosition"`
+	Width         int             `json:"width"`
+	Height        int             `json:"height"`
+	Version       int             `json:"version,omitempty"`
+	ViewMode      int             `json:"viewMode,omitempty"`
+	Contents      json.RawMessage `json:"contents,omitempty"`
+}
+
+// RichTextWidgetContent is the content of RICHTEXT and TITLE widgets
+type RichTextWidgetContent struct {
+	HTMLContent string `json:"htmlContent"`
+}
+
+// ChartWidgetContent is the content of CHART widgets
+type ChartWidgetContent struct {
+	SheetID           int64           `json:"sheetId,omitempty"`
+	ReportID          int64           `json:"reportId,omitempty"`
+	IncludedColumnIDs []int64         `json:"includedColumnIds,omitempty"`
+	SelectionRanges   json.RawMessage `json:"selectionRanges,omitempty"`
+	Axes              json.RawMessage `json:"axes,omitempty"`
+	Legend            json.RawMessage `json:"legend,omitempty"`
+	Series            json.RawMessage `json:"series,omitempty"`
+}
+
+// CellLinkWidgetContent is the content of METRIC widgets
+type CellLinkWidgetContent struct {
+	SheetID  int64          `json:"sheetId,omitempty"`
+	CellData []CellDataItem `json:"cellData,omitempty"`
+}
+
+// CellDataItem is a single cell shown by a METRIC widget
+type CellDataItem struct {
+	ColumnID  int64      `json:"columnId,omitempty"`
+	RowID     int64      `json:"rowId,omitempty"`
+	Label     string     `json:"label,omitempty"`
+	Order     int        `json:"order,omitempty"`
+	Cell      *Cell      `json:"cell,omitempty"`
+	Column    *Column    `json:"column,omitempty"`
+	ObjectVal *CellValue `json:"objectValue,omitempty"`
+}
+
+// ShortcutWidgetContent is the content of SHORTCUT, SHORTCUTICON and SHORTCUTLIST widgets
+type ShortcutWidgetContent struct {
+	ShortcutData []ShortcutDataItem `json:"shortcutData,omitempty"`
+}
+
+// ShortcutDataItem is a single link within a shortcut widget
+type ShortcutDataItem struct {
+	Label     string     `json:"label,omitempty"`
+	MimeType  string     `json:"mimeType,omitempty"`
+	Order     int        `json:"order,omitempty"`
+	Hyperlink *Hyperlink `json:"hyperlink,omitempty"`
+}
+
+// Hyperlink is a link to a URL or another SmartSheet object
+type Hyperlink struct {
+	URL      string `json:"url,omitempty"`
+	SheetID  int64  `json:"sheetId,omitempty"`
+	ReportID int64  `json:"reportId,omitempty"`
+	SightID  int64  `json:"sightId,omitempty"`
+}
+
+// ReportWidgetContent is the content of GRIDGANTT widgets
+type ReportWidgetContent struct {
+	ReportID    int64  `json:"reportId,omitempty"`
+	HTMLContent string `json:"htmlContent,omitempty"`
+}
+
+// ImageWidgetContent is the content of IMAGE widgets
+type ImageWidgetContent struct {
+	PrivateID string `json:"privateId,omitempty"`
+	FileName  string `json:"fileName,omitempty"`
+	Format    string `json:"format,omitempty"`
+	Height    int    `json:"height,omitempty"`
+	Width     int    `json:"width,omitempty"`
+}
+
+// WebContentWidgetContent is the content of WEBCONTENT widgets
+type WebContentWidgetContent struct {
+	URL string `json:"url,omitempty"`
+}
+
+// DecodeContents returns the typed contents of the widget based on its Type, such as *ChartWidgetContent.
+// Unknown widget types return the raw contents as json.RawMessage.
+func (w *Widget) DecodeContents() (interface{}, error) {
+	var v interface{}
+	switch w.Type {
+	case WidgetTypeRichText, WidgetTypeTitle:
+		v = &RichTextWidgetContent{}
+	case WidgetTypeChart:
+		v = &ChartWidgetContent{}
+	case WidgetTypeMetric:
+		v = &CellLinkWidgetContent{}
+	case WidgetTypeShortcut, WidgetTypeShortcutIcon, WidgetTypeShortcutList:
+		v = &ShortcutWidgetContent{}
+	case WidgetTypeGridGantt:
+		v = &ReportWidgetContent{}
+	case WidgetTypeImage:
+		v = &ImageWidgetContent{}
+	case WidgetTypeWebContent:
+		v = &WebContentWidgetContent{}
+	default:
+		return w.Contents, nil
+	}
+
+	if len(w.Contents) == 0 {
+		return v, nil
+	}
+
+	if err := json.Unmarshal(w.Contents, v); err != nil {
+		return nil, errors.Wrapf(err, "Failed to decode contents of %v widget %v", w.Type, w.ID)
+	}
+
+	return v, nil
+}
+
+// ListSights returns every dashboard the user has access to
+func (c *Client) ListSights() ([]Sight, error) {
+	return getAllPages[Sight](c, "sights")
+}
+
+// GetSight returns the dashboard including its widgets
+func (c *Client) GetSight(id string) (*Sight, error) {
+	s := &Sight{}
+	if err := c.getObject("sights/"+id, s); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get sight (ID: %v)", id)
+	}
+
+	return s, nil
+}
+
+// CopySight copies the dashboard to the destination returning the new dashboard
+func (c *Client) CopySight(id string, cd *ContainerDestination) (*Sight, error) {
+	return c.sightToDestination(fmt.Sprintf("sights/%v/copy", id), cd)
+}
+
+// MoveSight moves the dashboard to the destination folder or workspace
+func (c *Client) MoveSight(id string, cd *ContainerDestination) (*Sight, error) {
+	return c.sightToDestination(fmt.Sprintf("sights/%v/move", id), cd)
+}
+
+func (c *Client) sightToDestination(path string, cd *ContainerDestination) (*Sight, error) {
+	s := &Sight{}
+	body, err := c.postObjectInto(s, path, cd)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// DeleteSight removes the dashboard
+func (c *Client) DeleteSight(id string) error {
+	return c.deleteObject("sights/" + id)
+}
+
+// GetSightPublishStatus returns whether the dashboard is published
+func (c *Client) GetSightPublishStatus(id string) (*SightPublish, error) {
+	p := &SightPublish{}
+	if err := c.getObject(fmt.Sprintf("sights/%v/publish", id), p); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get publish status of sight (ID: %v)", id)
+	}
+
+	return p, nil
+}
+
+// SetSightPublishStatus publishes or unpublishes the dashboard, the returned status contains the published URL
+func (c *Client) SetSightPublishStatus(id string, p SightPublish) (*SightPublish, error) {
+	updated := &SightPublish{}
+	body, err := c.putObjectInto(updated, fmt.Sprintf("sights/%v/publish", id), p)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
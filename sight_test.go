@@ -0,0 +1,123 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWidget_DecodeContents(t *testing.T) {
+	assert := assert.New(t)
+
+	var s Sight
+	err := json.Unmarshal([]byte(`{"id":1,"name":"Board","widgets":[
+		{"id":1,"type":"RICHTEXT","contents":{"htmlContent":"<b>hi</b>"}},
+		{"id":2,"type":"CHART","contents":{"sheetId":7,"includedColumnIds":[70,71]}},
+		{"id":3,"type":"SHORTCUTLIST","contents":{"shortcutData":[{"label":"Tasks","hyperlink":{"sheetId":7}}]}},
+		{"id":4,"type":"GRIDGANTT","contents":{"reportId":9}},
+		{"id":5,"type":"METRIC","contents":{"sheetId":7,"cellData":[{"columnId":70,"rowId":10,"label":"Total"}]}},
+		{"id":6,"type":"FUTURE","contents":{"x":1}}]}`), &s)
+	assert.NoError(err)
+	assert.Len(s.Widgets, 6)
+
+	v, err := s.Widgets[0].DecodeContents()
+	assert.NoError(err)
+	assert.Equal("<b>hi</b>", v.(*RichTextWidgetContent).HTMLContent)
+
+	v, err = s.Widgets[1].DecodeContents()
+	assert.NoError(err)
+	assert.Equal(int64(7), v.(*ChartWidgetContent).SheetID)
+	assert.Equal([]int64{70, 71}, v.(*ChartWidgetContent).IncludedColumnIDs)
+
+	v, err = s.Widgets[2].DecodeContents()
+	assert.NoError(err)
+	assert.Equal(int64(7), v.(*ShortcutWidgetContent).ShortcutData[0].Hyperlink.SheetID)
+
+	v, err = s.Widgets[3].DecodeContents()
+	assert.NoError(err)
+	assert.Equal(int64(9), v.(*ReportWidgetContent).ReportID)
+
+	v, err = s.Widgets[4].DecodeContents()
+	assert.NoError(err)
+	assert.Equal("Total", v.(*CellLinkWidgetContent).CellData[0].Label)
+
+	v, err = s.Widgets[5].DecodeContents()
+	assert.NoError(err)
+	assert.Equal(json.RawMessage(`{"x":1}`), v)
+}
+
+func TestClient_Sights(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(b)))
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/2.0/sights":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":1,"name":"Board"},{"id":2,"name":"Other"}]}`)
+		case r.Method == "GET" && r.URL.Path == "/2.0/sights/1":
+			io.WriteString(w, `{"id":1,"name":"Board","workspace":{"id":3,"name":"Team"},"widgets":[{"id":5,"type":"TITLE"}]}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/sights/1/copy":
+			io.WriteString(w, `{"resultCode":0,"result":{"id":8,"name":"Copy"}}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/sights/1/move":
+			io.WriteString(w, `{"resultCode":0,"result":{"id":1,"name":"Board"}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/2.0/sights/1":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		case r.Method == "GET" && r.URL.Path == "/2.0/sights/1/publish":
+			io.WriteString(w, `{"readOnlyFullEnabled":false}`)
+		case r.Method == "PUT" && r.URL.Path == "/2.0/sights/1/publish":
+			io.WriteString(w, `{"resultCode":0,"result":{"readOnlyFullEnabled":true,"readOnlyFullAccessibleBy":"ALL","readOnlyFullUrl":"https://publish.smartsheet.com/abc"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	sights, err := c.ListSights()
+	assert.NoError(err)
+	assert.Len(sights, 2)
+	assert.Equal("GET /2.0/sights ", requests[0])
+
+	s, err := c.GetSight("1")
+	assert.NoError(err)
+	assert.Equal("Team", s.Workspace.Name)
+	assert.Equal(WidgetTypeTitle, s.Widgets[0].Type)
+	assert.Equal("GET /2.0/sights/1 ", requests[1])
+
+	s, err = c.CopySight("1", &ContainerDestination{Type: DestinatonTypeFolder, DestinationID: 4, NewName: "Copy"})
+	assert.NoError(err)
+	assert.Equal(int64(8), s.ID)
+	assert.Equal(`POST /2.0/sights/1/copy {"destinationType":"folder","destinationId":4,"newName":"Copy"}`, requests[2])
+
+	_, err = c.MoveSight("1", &ContainerDestination{Type: DestinatonTypeWorkspace, DestinationID: 3})
+	assert.NoError(err)
+	assert.Equal(`POST /2.0/sights/1/move {"destinationType":"workspace","destinationId":3}`, requests[3])
+
+	assert.NoError(c.DeleteSight("1"))
+	assert.Equal("DELETE /2.0/sights/1 ", requests[4])
+
+	p, err := c.GetSightPublishStatus("1")
+	assert.NoError(err)
+	assert.False(p.ReadOnlyFullEnabled)
+	assert.Equal("GET /2.0/sights/1/publish ", requests[5])
+
+	p, err = c.SetSightPublishStatus("1", SightPublish{ReadOnlyFullEnabled: true, ReadOnlyFullAccessibleBy: "ALL"})
+	assert.NoError(err)
+	assert.Equal("https://publish.smartsheet.com/abc", p.ReadOnlyFullURL)
+	assert.Equal(`PUT /2.0/sights/1/publish {"readOnlyFullEnabled":true,"readOnlyFullAccessibleBy":"ALL"}`, requests[6])
+
+	_, err = c.GetSight("2")
+	assert.Error(err)
+	assert.Contains(err.Error(), "Failed to get sight (ID: 2)")
+}
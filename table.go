@@ -0,0 +1,342 @@
+package goSmartSheet
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Table is a typed repository over a single sheet where every row is marshalled into a T via the `ss` struct tag.
+// Rows are identified by the value in the key column and the table keeps an index of key to row ID so
+// single row operations do not require a full scan of the sheet.
+//
+// A Table is not safe for concurrent use.
+type Table[T any] struct {
+	client    *Client
+	sheetID   string
+	keyColumn string
+
+	cols   []Column
+	keyCol Column
+	colIDs []string
+	index  map[string]int64 //key -> row ID
+}
+
+// NewTable returns a Table bound to the specified sheet where rows are identified by the column titled keyColumn
+func NewTable[T any](c *Client, sheetID, keyColumn string) (*Table[T], error) {
+	var zero T
+	fields, err := rowFields(reflect.TypeOf(zero))
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Table[T]{client: c, sheetID: sheetID, keyColumn: keyColumn}
+	if err = t.loadColumns(fields); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *Table[T]) loadColumns(fields []rowField) error {
+	sheetCols, err := t.client.GetColumns(t.sheetID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot retrieve columns for sheetID: %v", t.sheetID)
+	}
+
+	byTitle := make(map[string]Column, len(sheetCols))
+	for _, col := range sheetCols {
+		byTitle[col.Title] = col
+	}
+
+	keyMapped := false
+	for _, f := range fields {
+		if f.rowID {
+			continue
+		}
+
+		col, exists := byTitle[f.title]
+		if !exists {
+			return errors.Errorf("Column '%v' does not exist in sheet %v", f.title, t.sheetID)
+		}
+
+		t.cols = append(t.cols, col)
+		t.colIDs = append(t.colIDs, strconv.FormatInt(col.ID, 10))
+		if col.Title == t.keyColumn {
+			t.keyCol = col
+			keyMapped = true
+		}
+	}
+
+	if !keyMapped {
+		return errors.Errorf("Key column '%v' is not mapped by %T", t.keyColumn, *new(T))
+	}
+
+	return nil
+}
+
+// Refresh will reload the key index from the sheet
+func (t *Table[T]) Refresh() error {
+	_, err := t.load("")
+	return err
+}
+
+// load fetches the mapped columns of the sheet and rebuilds the key index when no rows are filtered.
+// An error is returned when more than one row has the same key, as the key would not identify a single row.
+func (t *Table[T]) load(rowFilter string) (*Sheet, error) {
+	filter := "columnIds=" + strings.Join(t.colIDs, ",")
+	if rowFilter != "" {
+		filter += "&" + rowFilter
+	}
+
+	s, err := t.client.GetSheet(t.sheetID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if rowFilter == "" {
+		index := make(map[string]int64, len(s.Rows))
+		for i := range s.Rows {
+			k := t.rowKey(&s.Rows[i])
+			if k == "" {
+				continue
+			}
+
+			if id, exists := index[k]; exists {
+				return nil, errors.Errorf("Key '%v' is used by rows %v and %v of sheet %v", k, id, s.Rows[i].ID, t.sheetID)
+			}
+			index[k] = s.Rows[i].ID
+		}
+		t.index = index
+	}
+
+	return s, nil
+}
+
+func (t *Table[T]) ensureIndex() error {
+	if t.index != nil {
+		return nil
+	}
+	return t.Refresh()
+}
+
+func (t *Table[T]) rowKey(r *Row) string {
+	for _, c := range r.Cells {
+		if c.ColumnID == t.keyCol.ID && c.Value != nil {
+			return c.Value.String()
+		}
+	}
+	return ""
+}
+
+func (t *Table[T]) marshal(item *T) (Row, string, error) {
+	r, err := MarshalRow(item, t.cols)
+	if err != nil {
+		return r, "", err
+	}
+
+	k := t.rowKey(&r)
+	if k == "" {
+		return r, "", errors.Errorf("Key column '%v' must have a value", t.keyColumn)
+	}
+
+	return r, k, nil
+}
+
+// Get returns the item stored under the specified key or ErrRowNotFound.  A key missing from the index reloads
+// the index once, so rows added outside of the table since it was loaded are found.
+func (t *Table[T]) Get(key string) (*T, error) {
+	loaded := t.index == nil
+	if err := t.ensureIndex(); err != nil {
+		return nil, err
+	}
+
+	id, exists := t.index[key]
+	if !exists && !loaded {
+		if err := t.Refresh(); err != nil {
+			return nil, err
+		}
+		id, exists = t.index[key]
+	}
+	if !exists {
+		return nil, ErrRowNotFound
+	}
+
+	s, err := t.load("rowIds=" + strconv.FormatInt(id, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.Rows) == 0 {
+		delete(t.index, key)
+		return nil, ErrRowNotFound
+	}
+
+	item := new(T)
+	if err = UnmarshalRow(&s.Rows[0], t.cols, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// List returns all items within the sheet that match the filter.  A nil filter returns every item.
+func (t *Table[T]) List(filter func(*T) bool) ([]T, error) {
+	s, err := t.load("")
+	if err != nil {
+		return nil, err
+	}
+
+	items := []T{}
+	for i := range s.Rows {
+		var item T
+		if err = UnmarshalRow(&s.Rows[i], t.cols, &item); err != nil {
+			return nil, errors.Wrapf(err, "Failed to unmarshal row %v", s.Rows[i].ID)
+		}
+
+		if filter == nil || filter(&item) {
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+// Insert adds the items to the bottom of the sheet.  Keys must not already exist.
+func (t *Table[T]) Insert(items ...T) error {
+	if err := t.ensureIndex(); err != nil {
+		return err
+	}
+
+	rows := make([]Row, 0, len(items))
+	keys := make(map[string]bool, len(items))
+	for i := range items {
+		r, k, err := t.marshal(&items[i])
+		if err != nil {
+			return err
+		}
+
+		if _, exists := t.index[k]; exists || keys[k] {
+			return errors.Errorf("Key '%v' already exists", k)
+		}
+		keys[k] = true
+
+		r.ID = 0
+		rows = append(rows, r)
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	body, err := t.client.AddRowsToSheet(t.sheetID, ToBottom, rows, NormalValidation)
+	if err != nil {
+		return err
+	}
+
+	var added []Row
+	if err = decodeAsResultResponseInto(body, &added); err != nil {
+		return err
+	}
+
+	for i := range added {
+		if k := t.rowKey(&added[i]); k != "" {
+			t.index[k] = added[i].ID
+		}
+	}
+
+	return nil
+}
+
+// Update replaces the mapped cells of the existing rows for each item.  Keys must already exist.
+func (t *Table[T]) Update(items ...T) error {
+	if err := t.ensureIndex(); err != nil {
+		return err
+	}
+
+	rows := make([]Row, 0, len(items))
+	for i := range items {
+		r, k, err := t.marshal(&items[i])
+		if err != nil {
+			return err
+		}
+
+		id, exists := t.index[k]
+		if !exists {
+			return errors.Wrapf(ErrRowNotFound, "Key '%v'", k)
+		}
+
+		r.ID = id
+		rows = append(rows, r)
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return t.client.updateRows(t.sheetID, rows)
+}
+
+// Upsert will update the items whose key already exists and insert the rest
+func (t *Table[T]) Upsert(items ...T) error {
+	if err := t.ensureIndex(); err != nil {
+		return err
+	}
+
+	var inserts, updates []T
+	for i := range items {
+		_, k, err := t.marshal(&items[i])
+		if err != nil {
+			return err
+		}
+
+		if _, exists := t.index[k]; exists {
+			updates = append(updates, items[i])
+		} else {
+			inserts = append(inserts, items[i])
+		}
+	}
+
+	if err := t.Update(updates...); err != nil {
+		return err
+	}
+
+	return t.Insert(inserts...)
+}
+
+// Delete removes the rows for the specified keys.  Keys must already exist.
+func (t *Table[T]) Delete(keys ...string) error {
+	if err := t.ensureIndex(); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		id, exists := t.index[k]
+		if !exists {
+			return errors.Wrapf(ErrRowNotFound, "Key '%v'", k)
+		}
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	body, _, err := t.client.DeleteRowsIdsFromSheet(t.sheetID, ids)
+	if err != nil {
+		return err
+	}
+
+	if err = decodeResult(body); err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		delete(t.index, k)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type tableItem struct {
+	Key   string `ss:"Key"`
+	Value int    `ss:"Value"`
+}
+
+func TestTable(t *testing.T) {
+	assert := assert.New(t)
+
+	var posted, put []Row
+	var deleted string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/2.0/sheets/1/columns":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":10,"title":"Key"},{"id":20,"title":"Value"}]}`)
+		case r.Method == "GET" && r.URL.Path == "/2.0/sheets/1":
+			io.WriteString(w, `{"id":1,"rows":[{"id":100,"cells":[{"columnId":10,"value":"a"},{"columnId":20,"value":1}]}]}`)
+		case r.Method == "POST":
+			json.NewDecoder(r.Body).Decode(&posted)
+			io.WriteString(w, `{"resultCode":0,"result":[{"id":200,"cells":[{"columnId":10,"value":"b"},{"columnId":20,"value":2}]}]}`)
+		case r.Method == "PUT":
+			json.NewDecoder(r.Body).Decode(&put)
+			io.WriteString(w, `{"resultCode":0,"result":[]}`)
+		case r.Method == "DELETE":
+			deleted = r.URL.Query().Get("ids")
+			io.WriteString(w, `{"resultCode":0,"result":[]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	tbl, err := NewTable[tableItem](c, "1", "Key")
+	assert.NoError(err)
+
+	item, err := tbl.Get("a")
+	assert.NoError(err)
+	assert.Equal(&tableItem{Key: "a", Value: 1}, item)
+
+	_, err = tbl.Get("missing")
+	assert.Equal(ErrRowNotFound, err)
+
+	assert.NoError(tbl.Upsert(tableItem{Key: "a", Value: 5}, tableItem{Key: "b", Value: 2}))
+	assert.Len(put, 1)
+	assert.Equal(int64(100), put[0].ID)
+	assert.Len(posted, 1)
+	assert.True(posted[0].ToBottom)
+
+	assert.Error(tbl.Insert(tableItem{Key: "b"}))
+
+	assert.NoError(tbl.Delete("b"))
+	assert.Equal("200", deleted)
+	assert.Error(tbl.Delete("b"))
+
+	_, err = NewTable[tableItem](c, "1", "Missing")
+	assert.Error(err)
+}
+
+func TestTable_DuplicateKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2.0/sheets/1/columns":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":10,"title":"Key"},{"id":20,"title":"Value"}]}`)
+		case "/2.0/sheets/1":
+			io.WriteString(w, `{"id":1,"rows":[{"id":100,"cells":[{"columnId":10,"value":"a"}]},{"id":101,"cells":[{"columnId":10,"value":"a"}]}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	tbl, err := NewTable[tableItem](c, "1", "Key")
+	assert.NoError(err)
+
+	err = tbl.Refresh()
+	assert.Error(err)
+	assert.Contains(err.Error(), "Key 'a' is used by rows 100 and 101")
+
+	_, err = tbl.Get("a")
+	assert.Error(err)
+}
+
+func TestTable_GetReloadsOnMiss(t *testing.T) {
+	assert := assert.New(t)
+
+	loads := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/2.0/sheets/1/columns":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":10,"title":"Key"},{"id":20,"title":"Value"}]}`)
+		case r.URL.Query().Get("rowIds") != "":
+			io.WriteString(w, `{"id":1,"rows":[{"id":101,"cells":[{"columnId":10,"value":"b"},{"columnId":20,"value":2}]}]}`)
+		default:
+			//row b is added outside of the table after the first load
+			loads++
+			if loads == 1 {
+				io.WriteString(w, `{"id":1,"rows":[{"id":100,"cells":[{"columnId":10,"value":"a"}]}]}`)
+				return
+			}
+			io.WriteString(w, `{"id":1,"rows":[{"id":100,"cells":[{"columnId":10,"value":"a"}]},{"id":101,"cells":[{"columnId":10,"value":"b"}]}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	tbl, err := NewTable[tableItem](c, "1", "Key")
+	assert.NoError(err)
+	assert.NoError(tbl.Refresh())
+
+	item, err := tbl.Get("b")
+	assert.NoError(err)
+	assert.Equal(&tableItem{Key: "b", Value: 2}, item)
+	assert.Equal(2, loads)
+
+	//a key missing from an index loaded by the same call is not reloaded again
+	tbl, err = NewTable[tableItem](c, "1", "Key")
+	assert.NoError(err)
+	_, err = tbl.Get("c")
+	assert.Equal(ErrRowNotFound, err)
+	assert.Equal(3, loads)
+}
@@ -0,0 +1,167 @@
+package goSmartSheet
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// UpsertResult reports how the rows passed to UpsertRows were applied
+type UpsertResult struct {
+	Inserted  int
+	Updated   int
+	Unchanged int
+}
+
+// UpsertRows will match each row to an existing row in the sheet based on the value in the column titled keyColumn.
+// Matching rows are updated with only the cells that changed, rows without a match are added to the bottom of the sheet.
+// Cells without a ColumnID are assigned the column at the same position, the same as AddRowsToSheet.
+// Duplicate keys within rows or within the sheet are rejected before any change is made.
+func (c *Client) UpsertRows(sheetID, keyColumn string, rows []Row) (res UpsertResult, err error) {
+	sheetCols, err := c.GetColumns(sheetID)
+	if err != nil {
+		err = errors.Wrapf(err, "Cannot retrieve columns for sheetID: %v", sheetID)
+		return
+	}
+
+	var keyCol *Column
+	for i := range sheetCols {
+		if sheetCols[i].Title == keyColumn {
+			keyCol = &sheetCols[i]
+			break
+		}
+	}
+	if keyCol == nil {
+		err = errors.Errorf("Key column '%v' does not exist in sheet %v", keyColumn, sheetID)
+		return
+	}
+
+	//resolve column ids and the keys of the new rows on copies, the rows of the caller are left unchanged
+	rows = copyRows(rows)
+	colIDs := []string{strconv.FormatInt(keyCol.ID, 10)}
+	seenCols := map[int64]bool{keyCol.ID: true}
+	keys := make([]string, len(rows))
+	seenKeys := make(map[string]bool, len(rows))
+	for i := range rows {
+		r := &rows[i]
+		if len(r.Cells) > len(sheetCols) {
+			err = errors.New("Cells within a row cannot be greater than the columns within the sheet")
+			return
+		}
+
+		for j := range r.Cells {
+			if r.Cells[j].ColumnID == 0 {
+				r.Cells[j].ColumnID = sheetCols[j].ID
+			}
+
+			id := r.Cells[j].ColumnID
+			if !seenCols[id] {
+				seenCols[id] = true
+				colIDs = append(colIDs, strconv.FormatInt(id, 10))
+			}
+
+			if id == keyCol.ID {
+				keys[i] = r.Cells[j].Value.normalized()
+			}
+		}
+
+		if keys[i] == "" {
+			err = errors.Errorf("Row %v is missing a value for key column '%v'", i, keyColumn)
+			return
+		}
+
+		if seenKeys[keys[i]] {
+			err = errors.Errorf("Duplicate key '%v' in rows", keys[i])
+			return
+		}
+		seenKeys[keys[i]] = true
+	}
+
+	//index the existing rows by key
+	s, err := c.GetSheetFilterCols(sheetID, colIDs)
+	if err != nil {
+		return
+	}
+
+	existing := make(map[string]*Row, len(s.Rows))
+	for i := range s.Rows {
+		r := &s.Rows[i]
+		for _, cell := range r.Cells {
+			if cell.ColumnID != keyCol.ID {
+				continue
+			}
+
+			k := cell.Value.normalized()
+			if k == "" {
+				break
+			}
+
+			if _, exists := existing[k]; exists {
+				err = errors.Errorf("Duplicate key '%v' in sheet %v", k, sheetID)
+				return
+			}
+			existing[k] = r
+		}
+	}
+
+	//split into adds and updates
+	var adds, updates []Row
+	for i := range rows {
+		cur, exists := existing[keys[i]]
+		if !exists {
+			adds = append(adds, rows[i])
+			continue
+		}
+
+		curCells := make(map[int64]*CellValue, len(cur.Cells))
+		for j := range cur.Cells {
+			curCells[cur.Cells[j].ColumnID] = cur.Cells[j].Value
+		}
+
+		upd := Row{ID: cur.ID}
+		for _, cell := range rows[i].Cells {
+			if !cell.Value.Equal(curCells[cell.ColumnID]) {
+				upd.Cells = append(upd.Cells, Cell{ColumnID: cell.ColumnID, Value: cell.Value})
+			}
+		}
+
+		if len(upd.Cells) == 0 {
+			res.Unchanged++
+			continue
+		}
+		updates = append(updates, upd)
+	}
+
+	if len(updates) > 0 {
+		if err = c.updateRows(sheetID, updates); err != nil {
+			err = errors.Wrap(err, "Failed to update rows")
+			return
+		}
+		res.Updated = len(updates)
+	}
+
+	if len(adds) > 0 {
+		body, err := c.AddRowsToSheet(sheetID, ToBottom, adds, NormalValidation)
+		if err != nil {
+			return res, errors.Wrap(err, "Failed to add rows")
+		}
+
+		var added []Row
+		if err = decodeAsResultResponseInto(body, &added); err != nil {
+			return res, err
+		}
+		res.Inserted = len(adds)
+	}
+
+	return
+}
+
+// copyRows returns a copy of the rows with their own cells, cell values are shared
+func copyRows(rows []Row) []Row {
+	cp := make([]Row, len(rows))
+	for i := range rows {
+		cp[i] = rows[i]
+		cp[i].Cells = append([]Cell(nil), rows[i].Cells...)
+	}
+	return cp
+}
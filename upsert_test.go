@@ -0,0 +1,70 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_UpsertRows(t *testing.T) {
+	assert := assert.New(t)
+
+	var posted, put []Row
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/2.0/sheets/1/columns":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":10,"title":"Key"},{"id":20,"title":"Value"}]}`)
+		case r.Method == "GET" && r.URL.Path == "/2.0/sheets/1":
+			query = r.URL.RawQuery
+			io.WriteString(w, `{"id":1,"rows":[
+				{"id":100,"cells":[{"columnId":10,"value":"a"},{"columnId":20,"value":1}]},
+				{"id":101,"cells":[{"columnId":10,"value":"b"},{"columnId":20,"value":2.0}]}]}`)
+		case r.Method == "POST":
+			json.NewDecoder(r.Body).Decode(&posted)
+			io.WriteString(w, `{"resultCode":0,"result":[]}`)
+		case r.Method == "PUT":
+			json.NewDecoder(r.Body).Decode(&put)
+			io.WriteString(w, `{"resultCode":0,"result":[]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	row := func(k string, v int) Row {
+		var kv, vv CellValue
+		kv.SetString(k)
+		vv.SetInt(v)
+		return Row{Cells: []Cell{{Value: &kv}, {ColumnID: 20, Value: &vv}}}
+	}
+
+	in := []Row{row("a", 5), row("b", 2), row("c", 3)}
+	res, err := c.UpsertRows("1", "Key", in)
+	assert.NoError(err)
+	assert.Equal(int64(0), in[2].Cells[0].ColumnID, "rows of the caller are not changed")
+	assert.False(in[2].ToBottom)
+	assert.Equal(UpsertResult{Inserted: 1, Updated: 1, Unchanged: 1}, res)
+	assert.Equal("columnIds=10,20", query)
+
+	assert.Len(put, 1)
+	assert.Equal(int64(100), put[0].ID)
+	assert.Len(put[0].Cells, 1)
+	assert.Equal(int64(20), put[0].Cells[0].ColumnID)
+
+	assert.Len(posted, 1)
+	assert.Equal(int64(10), posted[0].Cells[0].ColumnID)
+
+	_, err = c.UpsertRows("1", "Key", []Row{row("a", 1), row("a", 2)})
+	assert.Error(err)
+
+	_, err = c.UpsertRows("1", "Missing", []Row{row("a", 1)})
+	assert.Error(err)
+}
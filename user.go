@@ -0,0 +1,171 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// User statuses within the organization
+const (
+	UserStatusActive      = "ACTIVE"
+	UserStatusPending     = "PENDING"
+	UserStatusDeclined    = "DECLINED"
+	UserStatusDeactivated = "DEACTIVATED"
+)
+
+// User is a member of the organization account.  Admin and LicensedSheetCreator are always sent as they are
+// required by AddUser, use UpdateUserRequest to change only some properties of a user.
+// https://smartsheet-platform.github.io/api-docs/#user-object
+type User struct {
+	ID                   int64      `json:"id,omitempty"`
+	Email                string     `json:"email,omitempty"`
+	Name                 string     `json:"name,omitempty"`
+	FirstName            string     `json:"firstName,omitempty"`
+	LastName             string     `json:"lastName,omitempty"`
+	Admin                bool       `json:"admin"`
+	LicensedSheetCreator bool       `json:"licensedSheetCreator"`
+	GroupAdmin           bool       `json:"groupAdmin,omitempty"`
+	ResourceViewer       bool       `json:"resourceViewer,omitempty"`
+	Status               string     `json:"status,omitempty"`
+	SheetCount           int        `json:"sheetCount,omitempty"`
+	LastLogin            *time.Time `json:"lastLogin,omitempty"`
+}
+
+// Contact is a personal contact of the user
+// https://smartsheet-platform.github.io/api-docs/#contact-object
+type Contact struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// AsUser returns a client which makes every request on behalf of the user with the specified email
+// using the Assume-User header.  This requires an admin token, the original client is unchanged.
+func (c *Client) AsUser(email string) *Client {
+	return c.WithOptions(WithAssumeUser(email))
+}
+
+// ListUsers returns every user within the organization
+func (c *Client) ListUsers() ([]User, error) {
+	return getAllPages[User](c, "users")
+}
+
+// FindUserByEmail returns the user with the email, or nil when there is no such user within the organization
+func (c *Client) FindUserByEmail(email string) (*User, error) {
+	users, err := getAllPages[User](c, "users?email="+url.QueryEscape(email))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+	return &users[0], nil
+}
+
+// GetUser returns the user with the specified ID
+func (c *Client) GetUser(id int64) (*User, error) {
+	u := &User{}
+	if err := c.getObject(fmt.Sprintf("users/%v", id), u); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get user (ID: %v)", id)
+	}
+
+	return u, nil
+}
+
+// GetCurrentUser returns the user the token belongs to, or the assumed user
+func (c *Client) GetCurrentUser() (*User, error) {
+	u := &User{}
+	if err := c.getObject("users/me", u); err != nil {
+		return nil, errors.Wrap(err, "Failed to get current user")
+	}
+
+	return u, nil
+}
+
+// AddUser adds the user to the organization, sendEmail controls whether they are sent an invitation
+func (c *Client) AddUser(u User, sendEmail bool) (*User, error) {
+	added := &User{}
+	body, err := c.postObjectInto(added, fmt.Sprintf("users?sendEmail=%v", sendEmail), u)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to add user %v", u.Email)
+	}
+
+	if err = decodeAsResultResponseInto(body, added); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// UpdateUserRequest contains the names and roles of a user to change, nil properties are left unchanged
+type UpdateUserRequest struct {
+	FirstName            *string `json:"firstName,omitempty"`
+	LastName             *string `json:"lastName,omitempty"`
+	Admin                *bool   `json:"admin,omitempty"`
+	LicensedSheetCreator *bool   `json:"licensedSheetCreator,omitempty"`
+	GroupAdmin           *bool   `json:"groupAdmin,omitempty"`
+	ResourceViewer       *bool   `json:"resourceViewer,omitempty"`
+}
+
+// UpdateUser changes only the names and roles of the user which are set within u
+func (c *Client) UpdateUser(id int64, u UpdateUserRequest) (*User, error) {
+	updated := &User{}
+	body, err := c.putObjectInto(updated, fmt.Sprintf("users/%v", id), u)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to update user (ID: %v)", id)
+	}
+
+	if err = decodeAsResultResponseInto(body, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeactivateUser deactivates the user, they will no longer be able to access the organization
+func (c *Client) DeactivateUser(id int64) error {
+	return c.postAction(fmt.Sprintf("users/%v/deactivate", id))
+}
+
+// ReactivateUser reactivates a previously deactivated user
+func (c *Client) ReactivateUser(id int64) error {
+	return c.postAction(fmt.Sprintf("users/%v/reactivate", id))
+}
+
+// RemoveUser removes the user from the organization.  When transferTo is not 0 the items owned by the user
+// are transferred to that user, removeFromSharing also removes the user from every share.
+func (c *Client) RemoveUser(id int64, transferTo int64, removeFromSharing bool) error {
+	q := url.Values{}
+	if transferTo != 0 {
+		q.Set("transferTo", fmt.Sprint(transferTo))
+		q.Set("transferSheets", "true")
+	}
+	if removeFromSharing {
+		q.Set("removeFromSharing", "true")
+	}
+
+	path := fmt.Sprintf("users/%v", id)
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+	return c.deleteObject(path)
+}
+
+// ListContacts returns the personal contacts of the user
+func (c *Client) ListContacts() ([]Contact, error) {
+	return getAllPages[Contact](c, "contacts")
+}
+
+// GetContact returns the contact with the specified ID
+func (c *Client) GetContact(id string) (*Contact, error) {
+	ct := &Contact{}
+	if err := c.getObject("contacts/"+id, ct); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get contact (ID: %v)", id)
+	}
+
+	return ct, nil
+}
@@ -0,0 +1,85 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Users(t *testing.T) {
+	assert := assert.New(t)
+
+	var assumed []string
+	var added User
+	var updated string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assumed = append(assumed, r.Header.Get("Assume-User"))
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/2.0/users/me":
+			io.WriteString(w, `{"id":2,"email":"jane+test@b.com"}`)
+		case r.Method == "GET" && r.URL.Path == "/2.0/users":
+			if r.URL.Query().Get("email") == "a@b.com" {
+				io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":1,"email":"a@b.com","status":"ACTIVE"}]}`)
+				return
+			}
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[]}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/users":
+			assert.Equal("sendEmail=true", r.URL.RawQuery)
+			json.NewDecoder(r.Body).Decode(&added)
+			io.WriteString(w, `{"resultCode":0,"result":{"id":3,"email":"new@b.com","status":"PENDING"}}`)
+		case r.Method == "PUT" && r.URL.Path == "/2.0/users/1":
+			b, _ := io.ReadAll(r.Body)
+			updated = string(b)
+			io.WriteString(w, `{"resultCode":0,"result":{"id":1,"firstName":"Ann","admin":true,"licensedSheetCreator":true}}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/users/1/deactivate":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	u, err := c.FindUserByEmail("a@b.com")
+	assert.NoError(err)
+	assert.Equal(UserStatusActive, u.Status)
+
+	u, err = c.FindUserByEmail("missing@b.com")
+	assert.NoError(err)
+	assert.Nil(u)
+
+	u, err = c.AddUser(User{Email: "new@b.com", LicensedSheetCreator: true}, true)
+	assert.NoError(err)
+	assert.Equal(int64(3), u.ID)
+	assert.True(added.LicensedSheetCreator)
+
+	//a partial update does not send the roles, which would otherwise be removed
+	name := "Ann"
+	u, err = c.UpdateUser(1, UpdateUserRequest{FirstName: &name})
+	assert.NoError(err)
+	assert.True(u.Admin)
+	assert.JSONEq(`{"firstName":"Ann"}`, updated)
+
+	admin := false
+	_, err = c.UpdateUser(1, UpdateUserRequest{Admin: &admin})
+	assert.NoError(err)
+	assert.JSONEq(`{"admin":false}`, updated)
+
+	assert.NoError(c.DeactivateUser(1))
+	assert.Error(c.DeactivateUser(9))
+
+	//the assumed user only applies to the derived client
+	assumed = nil
+	_, err = c.AsUser("jane+test@b.com").GetCurrentUser()
+	assert.NoError(err)
+	_, err = c.GetCurrentUser()
+	assert.NoError(err)
+	assert.Equal([]string{"jane%2Btest%40b.com", ""}, assumed)
+}
@@ -0,0 +1,223 @@
+package goSmartSheet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RowChangeType is the kind of change reported by Watch
+type RowChangeType string
+
+const (
+	RowAdded   RowChangeType = "added"
+	RowChanged RowChangeType = "changed"
+	RowDeleted RowChangeType = "deleted"
+)
+
+// RowChange is a single row change detected by Watch
+type RowChange struct {
+	Type    RowChangeType
+	SheetID string
+	// Version is the version of the sheet the change was detected in
+	Version int
+	// Row is the current row, nil for deleted rows
+	Row *Row
+	// OldRow is the row from the previous snapshot, nil for added rows
+	OldRow *Row
+
+	// Err is populated, with no other fields, when polling failed.  Watch keeps polling after errors.
+	Err error
+}
+
+// GetSheetVersion returns the current version of the sheet, which changes whenever the sheet is modified
+func (c *Client) GetSheetVersion(sheetID string) (int, error) {
+	return c.getSheetVersion(context.Background(), sheetID)
+}
+
+func (c *Client) getSheetVersion(ctx context.Context, sheetID string) (int, error) {
+	var v struct {
+		Version int `json:"version"`
+	}
+
+	if err := c.getObjectContext(ctx, fmt.Sprintf("sheets/%v/version", sheetID), &v); err != nil {
+		return 0, errors.Wrapf(err, "Failed to get sheet version (ID: %v)", sheetID)
+	}
+
+	return v.Version, nil
+}
+
+// Watch polls the version of the sheet every interval and emits the rows that were added, changed or deleted.
+// Only rows modified since the last poll are fetched, using rowsModifiedSince, and compared against a local snapshot.
+// Whenever the version changes only the primary column of the sheet is also fetched, and rows of the snapshot
+// that no longer exist are emitted as deleted.
+//
+// The channel is unbuffered so polling waits for the consumer, and it is closed when ctx is done.
+// Every request is made with ctx, so cancelling it also aborts a poll in progress.
+// The initial state of the sheet is loaded before Watch returns and is not emitted.
+func (c *Client) Watch(ctx context.Context, sheetID string, interval time.Duration) (<-chan RowChange, error) {
+	w := &sheetWatcher{client: c, sheetID: sheetID, out: make(chan RowChange)}
+	if err := w.load(ctx); err != nil {
+		return nil, err
+	}
+
+	go w.run(ctx, interval)
+	return w.out, nil
+}
+
+type sheetWatcher struct {
+	client       *Client
+	sheetID      string
+	out          chan RowChange
+	version      int
+	since        time.Time
+	primaryColID int64
+	rows         map[int64]*Row
+}
+
+// load takes the initial snapshot of the sheet
+func (w *sheetWatcher) load(ctx context.Context) error {
+	s, err := w.client.GetSheetWithContext(ctx, w.sheetID, "")
+	if err != nil {
+		return err
+	}
+
+	w.version = s.Version
+	w.since = s.ModifiedAt
+	w.rows = make(map[int64]*Row, len(s.Rows))
+	for i := range s.Rows {
+		w.rows[s.Rows[i].ID] = &s.Rows[i]
+	}
+
+	for _, col := range s.Columns {
+		if col.Primary {
+			w.primaryColID = col.ID
+		}
+	}
+
+	return nil
+}
+
+func (w *sheetWatcher) run(ctx context.Context, interval time.Duration) {
+	defer close(w.out)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changes, err := w.poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			changes = []RowChange{{SheetID: w.sheetID, Err: err}}
+		}
+
+		for _, ch := range changes {
+			select {
+			case w.out <- ch:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// poll returns the changes since the previous poll and updates the snapshot
+func (w *sheetWatcher) poll(ctx context.Context) ([]RowChange, error) {
+	v, err := w.client.getSheetVersion(ctx, w.sheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if v == w.version {
+		return nil, nil
+	}
+
+	s, err := w.client.GetSheetWithContext(ctx, w.sheetID, "rowsModifiedSince="+w.since.UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+
+	//both requests are made before the snapshot is changed so a failed poll can be repeated
+	current, err := w.rowIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []RowChange
+	since := w.since
+	for i := range s.Rows {
+		r := &s.Rows[i]
+		if r.ModifiedAt != nil && r.ModifiedAt.After(since) {
+			since = *r.ModifiedAt
+		}
+
+		old, exists := w.rows[r.ID]
+		switch {
+		case !exists:
+			changes = append(changes, RowChange{Type: RowAdded, SheetID: w.sheetID, Version: s.Version, Row: r})
+		case !rowCellsEqual(old, r):
+			changes = append(changes, RowChange{Type: RowChanged, SheetID: w.sheetID, Version: s.Version, Row: r, OldRow: old})
+		}
+		w.rows[r.ID] = r
+	}
+
+	var deleted []int64
+	for id := range w.rows {
+		if !current[id] {
+			deleted = append(deleted, id)
+		}
+	}
+	sort.Slice(deleted, func(i, j int) bool { return deleted[i] < deleted[j] })
+
+	for _, id := range deleted {
+		changes = append(changes, RowChange{Type: RowDeleted, SheetID: w.sheetID, Version: s.Version, OldRow: w.rows[id]})
+		delete(w.rows, id)
+	}
+
+	w.version = s.Version
+	w.since = since
+	return changes, nil
+}
+
+// rowIDs fetches only the primary column of the sheet and returns the IDs of every row
+func (w *sheetWatcher) rowIDs(ctx context.Context) (map[int64]bool, error) {
+	s, err := w.client.GetSheetWithContext(ctx, w.sheetID, "columnIds="+strconv.FormatInt(w.primaryColID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[int64]bool, len(s.Rows))
+	for _, r := range s.Rows {
+		ids[r.ID] = true
+	}
+
+	return ids, nil
+}
+
+// rowCellsEqual compares the values of every cell within both rows
+func rowCellsEqual(a, b *Row) bool {
+	if len(a.Cells) != len(b.Cells) {
+		return false
+	}
+
+	for i := range a.Cells {
+		other := cellFor(b, a.Cells[i].ColumnID)
+		if other == nil || !a.Cells[i].Value.Equal(other.Value) {
+			return false
+		}
+	}
+
+	return true
+}
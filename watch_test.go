@@ -0,0 +1,160 @@
+package goSmartSheet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Watch(t *testing.T) {
+	assert := assert.New(t)
+
+	var version int32 = 1
+	var modifiedSince, idsOnly atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.URL.Path == "/2.0/sheets/1/version":
+			io.WriteString(w, `{"version":`+strconv.Itoa(int(atomic.LoadInt32(&version)))+`}`)
+		case q.Get("rowsModifiedSince") != "":
+			modifiedSince.Store(q.Get("rowsModifiedSince"))
+			io.WriteString(w, `{"id":1,"version":2,"totalRowCount":2,"rows":[
+				{"id":10,"modifiedAt":"2017-05-22T05:30:27Z","cells":[{"columnId":1,"value":"changed"}]},
+				{"id":12,"modifiedAt":"2017-05-22T05:30:28Z","cells":[{"columnId":1,"value":"new"}]}]}`)
+		case q.Get("columnIds") != "":
+			idsOnly.Store(q.Get("columnIds"))
+			io.WriteString(w, `{"id":1,"version":2,"rows":[{"id":10},{"id":12}]}`)
+		default:
+			io.WriteString(w, `{"id":1,"version":1,"modifiedAt":"2017-05-22T00:00:00Z","totalRowCount":2,
+				"columns":[{"id":1,"title":"Name","primary":true}],
+				"rows":[{"id":10,"cells":[{"columnId":1,"value":"a"}]},{"id":11,"cells":[{"columnId":1,"value":"b"}]}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := c.Watch(ctx, "1", 5*time.Millisecond)
+	assert.NoError(err)
+
+	atomic.StoreInt32(&version, 2)
+
+	var got []RowChange
+	for len(got) < 3 {
+		select {
+		case ch := <-changes:
+			assert.NoError(ch.Err)
+			got = append(got, ch)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for changes")
+		}
+	}
+
+	assert.Equal("2017-05-22T00:00:00Z", modifiedSince.Load())
+	assert.Equal("1", idsOnly.Load())
+
+	assert.Equal(RowChanged, got[0].Type)
+	assert.Equal("a", got[0].OldRow.Cells[0].Value.String())
+	assert.Equal("changed", got[0].Row.Cells[0].Value.String())
+	assert.Equal(2, got[0].Version)
+
+	assert.Equal(RowAdded, got[1].Type)
+	assert.Equal(int64(12), got[1].Row.ID)
+
+	assert.Equal(RowDeleted, got[2].Type)
+	assert.Equal(int64(11), got[2].OldRow.ID)
+
+	cancel()
+	for range changes {
+	}
+}
+
+func TestClient_WatchCancelPoll(t *testing.T) {
+	assert := assert.New(t)
+
+	polling := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/2.0/sheets/1/version" {
+			polling <- struct{}{}
+			<-r.Context().Done() //never answers, the client must abort the request
+			return
+		}
+		io.WriteString(w, `{"id":1,"version":1,"columns":[{"id":1,"title":"Name","primary":true}]}`)
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := c.Watch(ctx, "1", time.Millisecond)
+	assert.NoError(err)
+
+	<-polling
+	cancel()
+
+	select {
+	case _, open := <-changes:
+		assert.False(open, "no error is emitted for the aborted poll")
+	case <-time.After(time.Second):
+		t.Fatal("poll was not aborted")
+	}
+}
+
+func TestClient_WatchDeleteWithUnchangedCount(t *testing.T) {
+	assert := assert.New(t)
+
+	var version int32 = 1
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.URL.Path == "/2.0/sheets/1/version":
+			io.WriteString(w, `{"version":`+strconv.Itoa(int(atomic.LoadInt32(&version)))+`}`)
+		case q.Get("rowsModifiedSince") != "":
+			//the stale count must not hide the deleted row
+			io.WriteString(w, `{"id":1,"version":2,"totalRowCount":2,"rows":[]}`)
+		case q.Get("columnIds") != "":
+			io.WriteString(w, `{"id":1,"version":2,"rows":[{"id":10}]}`)
+		default:
+			io.WriteString(w, `{"id":1,"version":1,"modifiedAt":"2017-05-22T00:00:00Z","totalRowCount":2,
+				"columns":[{"id":1,"title":"Name","primary":true}],
+				"rows":[{"id":10,"cells":[{"columnId":1,"value":"a"}]},{"id":11,"cells":[{"columnId":1,"value":"b"}]}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := c.Watch(ctx, "1", 5*time.Millisecond)
+	assert.NoError(err)
+
+	atomic.StoreInt32(&version, 2)
+
+	select {
+	case ch := <-changes:
+		assert.NoError(ch.Err)
+		assert.Equal(RowDeleted, ch.Type)
+		assert.Equal(int64(11), ch.OldRow.ID)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for changes")
+	}
+
+	cancel()
+	for range changes {
+	}
+}
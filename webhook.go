@@ -0,0 +1,161 @@
+package goSmartSheet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookScopeSheet is currently the only scope supported for webhooks
+const WebhookScopeSheet = "sheet"
+
+// WebhookAllEvents subscribes to every event on the scope object
+const WebhookAllEvents = "*.*"
+
+// Webhook is a subscription for callbacks when the scope object changes
+// https://smartsheet-platform.github.io/api-docs/#webhook-object
+type Webhook struct {
+	ID              int64            `json:"id,omitempty"`
+	Name            string           `json:"name"`
+	APIClientID     string           `json:"apiClientId,omitempty"`
+	APIClientName   string           `json:"apiClientName,omitempty"`
+	Scope           string           `json:"scope,omitempty"`
+	ScopeObjectID   int64            `json:"scopeObjectId,omitempty"`
+	Subscope        *WebhookSubscope `json:"subscope,omitempty"`
+	Events          []string         `json:"events,omitempty"`
+	CallbackURL     string           `json:"callbackUrl,omitempty"`
+	SharedSecret    string           `json:"sharedSecret,omitempty"`
+	Enabled         bool             `json:"enabled"`
+	Status          string           `json:"status,omitempty"`
+	DisabledDetails string           `json:"disabledDetails,omitempty"`
+	Version         int              `json:"version,omitempty"`
+	Stats           *WebhookStats    `json:"stats,omitempty"`
+	CreatedAt       *time.Time       `json:"createdAt,omitempty"`
+	ModifiedAt      *time.Time       `json:"modifiedAt,omitempty"`
+}
+
+// WebhookSubscope limits the callbacks of a webhook to changes within specific columns, it can only be set on creation
+type WebhookSubscope struct {
+	ColumnIDs []int64 `json:"columnIds,omitempty"`
+}
+
+// WebhookStats contains the callback history of a webhook
+type WebhookStats struct {
+	LastCallbackAttempt           *time.Time `json:"lastCallbackAttempt,omitempty"`
+	LastCallbackAttemptRetryCount int        `json:"lastCallbackAttemptRetryCount,omitempty"`
+	LastSuccessfulCallback        *time.Time `json:"lastSuccessfulCallback,omitempty"`
+}
+
+// webhookUpdate contains the only attributes of a Webhook that can be changed
+type webhookUpdate struct {
+	Name        string   `json:"name,omitempty"`
+	Enabled     bool     `json:"enabled"`
+	CallbackURL string   `json:"callbackUrl,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	Version     int      `json:"version,omitempty"`
+}
+
+// CreateWebhook creates a webhook which is disabled until enabled with UpdateWebhook or EnableWebhook.
+// Scope, Events and Version default to the sheet scope, all events and version 1.
+func (c *Client) CreateWebhook(w Webhook) (*Webhook, error) {
+	if w.Scope == "" {
+		w.Scope = WebhookScopeSheet
+	}
+	if len(w.Events) == 0 {
+		w.Events = []string{WebhookAllEvents}
+	}
+	if w.Version == 0 {
+		w.Version = 1
+	}
+
+	created := &Webhook{}
+	body, err := c.postObjectInto(created, "webhooks", w)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ListWebhooks returns every webhook owned by the user
+func (c *Client) ListWebhooks() ([]Webhook, error) {
+	return getAllPages[Webhook](c, "webhooks")
+}
+
+// GetWebhook returns the webhook with the specified ID
+func (c *Client) GetWebhook(id int64) (*Webhook, error) {
+	w := &Webhook{}
+	if err := c.getObject(fmt.Sprintf("webhooks/%v", id), w); err != nil {
+		return nil, errors.Wrapf(err, "Failed to get webhook (ID: %v)", id)
+	}
+
+	return w, nil
+}
+
+// UpdateWebhook changes the name, enabled state, callback URL, events and version of the webhook with the matching ID.
+// When enabling a webhook, SmartSheet verifies the callback URL before the response is returned.
+// The scope and subscope of a webhook are fixed when it is created and are not sent, SmartSheet does not allow
+// them to be changed.  To watch different columns, create a new webhook and delete the old one.
+func (c *Client) UpdateWebhook(w Webhook) (*Webhook, error) {
+	u := webhookUpdate{
+		Name:        w.Name,
+		Enabled:     w.Enabled,
+		CallbackURL: w.CallbackURL,
+		Events:      w.Events,
+		Version:     w.Version,
+	}
+
+	updated := &Webhook{}
+	body, err := c.putObjectInto(updated, fmt.Sprintf("webhooks/%v", w.ID), u)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// EnableWebhook will enable or disable the webhook
+func (c *Client) EnableWebhook(id int64, enabled bool) (*Webhook, error) {
+	updated := &Webhook{}
+	body, err := c.putObjectInto(updated, fmt.Sprintf("webhooks/%v", id), webhookUpdate{Enabled: enabled})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeAsResultResponseInto(body, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteWebhook removes the webhook
+func (c *Client) DeleteWebhook(id int64) error {
+	return c.deleteObject(fmt.Sprintf("webhooks/%v", id))
+}
+
+// ResetSharedSecret generates a new shared secret for the webhook and returns it
+func (c *Client) ResetSharedSecret(id int64) (string, error) {
+	var secret struct {
+		SharedSecret string `json:"sharedSecret"`
+	}
+	body, err := c.postObjectInto(&secret, fmt.Sprintf("webhooks/%v/resetsharedsecret", id), struct{}{})
+	if err != nil {
+		return "", err
+	}
+
+	if err = decodeAsResultResponseInto(body, &secret); err != nil {
+		return "", err
+	}
+
+	return secret.SharedSecret, nil
+}
@@ -0,0 +1,236 @@
+package goSmartSheet
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// HeaderHookChallenge is sent by SmartSheet when verifying a webhook callback URL
+	HeaderHookChallenge = "Smartsheet-Hook-Challenge"
+	// HeaderHookResponse echoes the challenge back to SmartSheet
+	HeaderHookResponse = "Smartsheet-Hook-Response"
+	// HeaderHmacSHA256 is the hex encoded HMAC of the callback body using the shared secret of the webhook
+	HeaderHmacSHA256 = "Smartsheet-Hmac-SHA256"
+
+	maxCallbackSize = 10 << 20
+)
+
+// WebhookCallback is the body of a request made by SmartSheet to a webhook callback URL
+// https://smartsheet-platform.github.io/api-docs/#callbacks
+type WebhookCallback struct {
+	Nonce         string         `json:"nonce"`
+	Timestamp     time.Time      `json:"timestamp"`
+	WebhookID     int64          `json:"webhookId"`
+	Scope         string         `json:"scope"`
+	ScopeObjectID int64          `json:"scopeObjectId"`
+	Events        []WebhookEvent `json:"events"`
+
+	//populated for verification and status change callbacks
+	Challenge        string `json:"challenge,omitempty"`
+	NewWebhookStatus string `json:"newWebhookStatus,omitempty"`
+}
+
+// WebhookEvent is a single change reported within a WebhookCallback
+type WebhookEvent struct {
+	ObjectType string    `json:"objectType"`
+	EventType  string    `json:"eventType"`
+	ID         int64     `json:"id"`
+	ColumnID   int64     `json:"columnId,omitempty"`
+	RowID      int64     `json:"rowId,omitempty"`
+	UserID     int64     `json:"userId,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// Type returns the dispatch type of the event such as row.created or cell.updated
+func (e *WebhookEvent) Type() string {
+	return e.ObjectType + "." + e.EventType
+}
+
+// WebhookEventFunc handles a single event from a callback.  Returning an error will fail the callback so SmartSheet retries it.
+type WebhookEventFunc func(cb *WebhookCallback, e *WebhookEvent) error
+
+// WebhookCallbackFunc handles an entire callback.  Returning an error will fail the callback so SmartSheet retries it.
+type WebhookCallbackFunc func(cb *WebhookCallback) error
+
+// WebhookHandler is an http.Handler for webhook callback URLs.  It answers the verification challenge,
+// checks the HMAC of each callback against the shared secret and dispatches events by type.
+//
+//	h := NewWebhookHandler(secret)
+//	h.On("row.created", func(cb *WebhookCallback, e *WebhookEvent) error { ... })
+//	http.Handle("/smartsheet", h)
+type WebhookHandler struct {
+	// SecretFor returns the shared secret of a webhook, it is used instead of the secret passed to NewWebhookHandler
+	// when a single handler serves multiple webhooks
+	SecretFor func(webhookID int64) (string, bool)
+
+	secret    string
+	mu        sync.RWMutex
+	events    map[string][]WebhookEventFunc
+	callbacks []WebhookCallbackFunc
+}
+
+// NewWebhookHandler returns a handler that verifies callbacks with the shared secret.
+// An empty secret disables HMAC verification, which should only be used for testing.
+func NewWebhookHandler(sharedSecret string) *WebhookHandler {
+	return &WebhookHandler{secret: sharedSecret, events: make(map[string][]WebhookEventFunc)}
+}
+
+// On registers fn for events of the type, such as row.created.  A * can be used for either part, e.g. cell.* or *.*
+func (h *WebhookHandler) On(eventType string, fn WebhookEventFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events[eventType] = append(h.events[eventType], fn)
+}
+
+// OnCallback registers fn to receive every verified callback, including status changes, before events are dispatched
+func (h *WebhookHandler) OnCallback(fn WebhookCallbackFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbacks = append(h.callbacks, fn)
+}
+
+// ServeHTTP implements http.Handler
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCallbackSize))
+	if err != nil {
+		http.Error(w, "Failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	cb := &WebhookCallback{}
+	if err = json.Unmarshal(body, cb); err != nil {
+		http.Error(w, "Failed to decode callback", http.StatusBadRequest)
+		return
+	}
+
+	if challenge := r.Header.Get(HeaderHookChallenge); challenge != "" {
+		//verification requests are only signed once the webhook has a secret
+		if r.Header.Get(HeaderHmacSHA256) != "" && !h.verify(cb.WebhookID, r.Header.Get(HeaderHmacSHA256), body) {
+			http.Error(w, "Invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set(HeaderHookResponse, challenge)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"smartsheetHookResponse": challenge})
+		return
+	}
+
+	if !h.verify(cb.WebhookID, r.Header.Get(HeaderHmacSHA256), body) {
+		http.Error(w, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	if err = h.Dispatch(cb); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch sends an already verified callback to the registered handlers.  Handlers registered while a callback
+// is being dispatched, including by the handlers themselves, receive the next callback.
+func (h *WebhookHandler) Dispatch(cb *WebhookCallback) error {
+	type eventHandlers struct {
+		key string
+		e   *WebhookEvent
+		fns []WebhookEventFunc
+	}
+
+	//the handlers are copied so they run without the lock and may call On or OnCallback
+	h.mu.RLock()
+	callbacks := append([]WebhookCallbackFunc(nil), h.callbacks...)
+	var handlers []eventHandlers
+	for i := range cb.Events {
+		e := &cb.Events[i]
+		for _, key := range []string{e.Type(), e.ObjectType + ".*", "*." + e.EventType, WebhookAllEvents} {
+			if fns := h.events[key]; len(fns) > 0 {
+				handlers = append(handlers, eventHandlers{key: key, e: e, fns: append([]WebhookEventFunc(nil), fns...)})
+			}
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, fn := range callbacks {
+		if err := fn(cb); err != nil {
+			return errors.Wrap(err, "Callback handler failed")
+		}
+	}
+
+	for _, eh := range handlers {
+		for _, fn := range eh.fns {
+			if err := fn(cb, eh.e); err != nil {
+				return errors.Wrapf(err, "Handler for %v failed", eh.key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h *WebhookHandler) verify(webhookID int64, signature string, body []byte) bool {
+	secret := h.secret
+	if h.SecretFor != nil {
+		var exists bool
+		if secret, exists = h.SecretFor(webhookID); !exists {
+			return false
+		}
+	}
+
+	if secret == "" {
+		return true
+	}
+
+	return VerifyWebhookSignature(secret, signature, body)
+}
+
+// VerifyWebhookSignature reports whether signature is the HMAC-SHA256 of body using the shared secret
+func VerifyWebhookSignature(sharedSecret, signature string, body []byte) bool {
+	got, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(got) == 0 {
+		return false
+	}
+
+	return hmac.Equal(got, SignWebhookBody(sharedSecret, body))
+}
+
+// SignWebhookBody returns the HMAC-SHA256 of body using the shared secret, as sent by SmartSheet in the Smartsheet-Hmac-SHA256 header
+func SignWebhookBody(sharedSecret string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(sharedSecret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// NewWebhookCallbackRequest builds a signed callback request, useful for testing handlers with httptest
+func NewWebhookCallbackRequest(url, sharedSecret string, cb *WebhookCallback) (*http.Request, error) {
+	body, err := json.Marshal(cb)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to encode callback")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create callback request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderHmacSHA256, hex.EncodeToString(SignWebhookBody(sharedSecret, body)))
+	return req, nil
+}
@@ -0,0 +1,132 @@
+package goSmartSheet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookHandler_Challenge(t *testing.T) {
+	assert := assert.New(t)
+	h := NewWebhookHandler("secret")
+
+	req := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(`{"challenge":"abc","webhookId":1}`))
+	req.Header.Set(HeaderHookChallenge, "abc")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("abc", rec.Header().Get(HeaderHookResponse))
+
+	var resp map[string]string
+	assert.NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal("abc", resp["smartsheetHookResponse"])
+}
+
+func TestWebhookHandler_Dispatch(t *testing.T) {
+	assert := assert.New(t)
+	h := NewWebhookHandler("secret")
+
+	var got []string
+	h.On("row.created", func(cb *WebhookCallback, e *WebhookEvent) error {
+		got = append(got, "row.created:"+e.Type())
+		return nil
+	})
+	h.On("cell.*", func(cb *WebhookCallback, e *WebhookEvent) error {
+		got = append(got, "cell.*:"+e.Type())
+		return nil
+	})
+	h.On(WebhookAllEvents, func(cb *WebhookCallback, e *WebhookEvent) error {
+		got = append(got, "all:"+e.Type())
+		return nil
+	})
+
+	cb := &WebhookCallback{WebhookID: 1, ScopeObjectID: 2, Events: []WebhookEvent{
+		{ObjectType: "row", EventType: "created", ID: 10},
+		{ObjectType: "cell", EventType: "updated", RowID: 10, ColumnID: 20},
+	}}
+
+	req, err := NewWebhookCallbackRequest("/hook", "secret", cb)
+	assert.NoError(err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal([]string{"row.created:row.created", "all:row.created", "cell.*:cell.updated", "all:cell.updated"}, got)
+
+	//wrong secret
+	req, _ = NewWebhookCallbackRequest("/hook", "other", cb)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+
+	//handler failures are returned so the callback is retried
+	h.On("row.*", func(cb *WebhookCallback, e *WebhookEvent) error { return errors.New("boom") })
+	req, _ = NewWebhookCallbackRequest("/hook", "secret", cb)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func TestWebhookHandler_RegisterWithinHandler(t *testing.T) {
+	assert := assert.New(t)
+	h := NewWebhookHandler("")
+
+	var got []string
+	h.OnCallback(func(cb *WebhookCallback) error {
+		h.OnCallback(func(cb *WebhookCallback) error {
+			got = append(got, "late callback")
+			return nil
+		})
+		return nil
+	})
+	h.On("row.created", func(cb *WebhookCallback, e *WebhookEvent) error {
+		got = append(got, "row.created")
+		h.On("row.*", func(cb *WebhookCallback, e *WebhookEvent) error {
+			got = append(got, "late row.*")
+			return nil
+		})
+		return nil
+	})
+
+	cb := &WebhookCallback{WebhookID: 1, Events: []WebhookEvent{{ObjectType: "row", EventType: "created", ID: 10}}}
+
+	done := make(chan error, 1)
+	go func() { done <- h.Dispatch(cb) }()
+
+	select {
+	case err := <-done:
+		assert.NoError(err)
+	case <-time.After(time.Second):
+		t.Fatal("Dispatch deadlocked")
+	}
+
+	//handlers registered during a dispatch only receive the next callback
+	assert.Equal([]string{"row.created"}, got)
+
+	got = nil
+	assert.NoError(h.Dispatch(&WebhookCallback{WebhookID: 1}))
+	assert.Equal([]string{"late callback"}, got)
+}
+
+func TestWebhookHandler_SecretFor(t *testing.T) {
+	assert := assert.New(t)
+	h := NewWebhookHandler("")
+	h.SecretFor = func(id int64) (string, bool) { return "s1", id == 1 }
+
+	req, _ := NewWebhookCallbackRequest("/hook", "s1", &WebhookCallback{WebhookID: 1})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(http.StatusOK, rec.Code)
+
+	req, _ = NewWebhookCallbackRequest("/hook", "s1", &WebhookCallback{WebhookID: 2})
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(http.StatusUnauthorized, rec.Code)
+}
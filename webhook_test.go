@@ -0,0 +1,91 @@
+package goSmartSheet
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Webhooks(t *testing.T) {
+	assert := assert.New(t)
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(b)))
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/2.0/webhooks":
+			io.WriteString(w, `{"resultCode":0,"result":{"id":7,"name":"Sync","scope":"sheet","scopeObjectId":1,"events":["*.*"],"version":1,"enabled":false,"status":"NEW_NOT_VERIFIED"}}`)
+		case r.Method == "GET" && r.URL.Path == "/2.0/webhooks":
+			io.WriteString(w, `{"pageNumber":1,"totalPages":1,"data":[{"id":7,"name":"Sync","enabled":true,"status":"ENABLED"}]}`)
+		case r.Method == "GET" && r.URL.Path == "/2.0/webhooks/7":
+			io.WriteString(w, `{"id":7,"name":"Sync","subscope":{"columnIds":[10,20]},"stats":{"lastCallbackAttemptRetryCount":2}}`)
+		case r.Method == "PUT" && r.URL.Path == "/2.0/webhooks/7":
+			io.WriteString(w, `{"resultCode":0,"result":{"id":7,"name":"Sync","enabled":true,"status":"ENABLED"}}`)
+		case r.Method == "POST" && r.URL.Path == "/2.0/webhooks/7/resetsharedsecret":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS","result":{"sharedSecret":"new-secret"}}`)
+		case r.Method == "DELETE" && r.URL.Path == "/2.0/webhooks/7":
+			io.WriteString(w, `{"resultCode":0,"message":"SUCCESS"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errorCode":1006,"message":"Not Found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := GetClient("key", srv.URL+"/2.0")
+	assert.NoError(err)
+
+	//scope, events and version are defaulted
+	created, err := c.CreateWebhook(Webhook{Name: "Sync", ScopeObjectID: 1, CallbackURL: "https://example.com/cb", Subscope: &WebhookSubscope{ColumnIDs: []int64{10}}})
+	assert.NoError(err)
+	assert.Equal(int64(7), created.ID)
+	assert.Equal("NEW_NOT_VERIFIED", created.Status)
+	assert.Equal(`POST /2.0/webhooks {"name":"Sync","scope":"sheet","scopeObjectId":1,"subscope":{"columnIds":[10]},"events":["*.*"],"callbackUrl":"https://example.com/cb","enabled":false,"version":1}`, requests[0])
+
+	hooks, err := c.ListWebhooks()
+	assert.NoError(err)
+	assert.Len(hooks, 1)
+	assert.True(hooks[0].Enabled)
+	assert.Equal("GET /2.0/webhooks ", requests[1])
+
+	hook, err := c.GetWebhook(7)
+	assert.NoError(err)
+	assert.Equal([]int64{10, 20}, hook.Subscope.ColumnIDs)
+	assert.Equal(2, hook.Stats.LastCallbackAttemptRetryCount)
+	assert.Equal("GET /2.0/webhooks/7 ", requests[2])
+
+	//only the attributes which can be changed are sent
+	hook.Enabled = true
+	hook.Events = []string{WebhookAllEvents}
+	hook.Version = 1
+	updated, err := c.UpdateWebhook(*hook)
+	assert.NoError(err)
+	assert.Equal("ENABLED", updated.Status)
+	assert.Equal(`PUT /2.0/webhooks/7 {"name":"Sync","enabled":true,"events":["*.*"],"version":1}`, requests[3])
+
+	_, err = c.EnableWebhook(7, true)
+	assert.NoError(err)
+	assert.Equal(`PUT /2.0/webhooks/7 {"enabled":true}`, requests[4])
+
+	_, err = c.EnableWebhook(7, false)
+	assert.NoError(err)
+	assert.Equal(`PUT /2.0/webhooks/7 {"enabled":false}`, requests[5])
+
+	secret, err := c.ResetSharedSecret(7)
+	assert.NoError(err)
+	assert.Equal("new-secret", secret)
+	assert.Equal(`POST /2.0/webhooks/7/resetsharedsecret {}`, requests[6])
+
+	assert.NoError(c.DeleteWebhook(7))
+	assert.Equal("DELETE /2.0/webhooks/7 ", requests[7])
+
+	_, err = c.GetWebhook(8)
+	assert.Error(err)
+	assert.Contains(err.Error(), "Failed to get webhook (ID: 8)")
+}